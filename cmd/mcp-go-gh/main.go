@@ -1,17 +1,66 @@
 package main
 
 import (
+	"cmp"
 	"context"
+	"flag"
+	"fmt"
 	"log/slog"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
+	"github.com/khalideidoo/mcp-go-gh/internal/audit"
 	"github.com/khalideidoo/mcp-go-gh/internal/commands/generated"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/httpserver"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolset"
 )
 
 func main() {
+	defaultTimeout := flag.Duration("default-timeout", 5*time.Minute, "default timeout for gh commands without a declared timeout")
+	maxTimeout := flag.Duration("max-timeout", time.Hour, "maximum timeout for any gh command, including per-call overrides (0 for no limit)")
+	workdir := flag.String("workdir", "", "directory gh commands run in when a call does not pass cwd (default: the server's working directory)")
+	redaction := flag.String("redaction", string(executor.RedactLogs), "where to redact secrets: off, logs, or all (logs plus tool results)")
+	var redactPatterns []string
+	flag.Func("redact-pattern", "regular expression whose matches are redacted in addition to the built-in secrets (repeatable)", func(expr string) error {
+		redactPatterns = append(redactPatterns, expr)
+		return nil
+	})
+	toolsets := flag.String("toolsets", os.Getenv("MCP_GH_TOOLSETS"), "comma-separated toolsets (gh command groups, or context) to enable, or all (env MCP_GH_TOOLSETS; default: all)")
+	excludeToolsets := flag.String("exclude-toolsets", os.Getenv("MCP_GH_EXCLUDE_TOOLSETS"), "comma-separated toolsets to disable (env MCP_GH_EXCLUDE_TOOLSETS)")
+	tools := flag.String("tools", os.Getenv("MCP_GH_TOOLS"), "comma-separated tool name globs to enable in addition to the toolsets (env MCP_GH_TOOLS)")
+	excludeTools := flag.String("exclude-tools", os.Getenv("MCP_GH_EXCLUDE_TOOLS"), "comma-separated tool name globs to disable, e.g. gh_repo_delete (env MCP_GH_EXCLUDE_TOOLS)")
+	readOnly := flag.Bool("read-only", os.Getenv("MCP_GH_READ_ONLY") == "true", "register only tools that cannot change anything, and limit gh api to GET (env MCP_GH_READ_ONLY=true)")
+	confirmDestructive := flag.Bool("confirm-destructive", os.Getenv("MCP_GH_CONFIRM_DESTRUCTIVE") == "true", "ask the user to approve each destructive command before it runs (env MCP_GH_CONFIRM_DESTRUCTIVE=true)")
+	transport := flag.String("transport", cmp.Or(os.Getenv("MCP_GH_TRANSPORT"), httpserver.TransportStdio), "how clients connect: stdio, http (streamable HTTP) or sse (env MCP_GH_TRANSPORT)")
+	listen := flag.String("listen", cmp.Or(os.Getenv("MCP_GH_LISTEN"), "127.0.0.1:8080"), "address to listen on with the http and sse transports (env MCP_GH_LISTEN)")
+	authToken := flag.String("auth-token", os.Getenv("MCP_GH_AUTH_TOKEN"), "bearer token HTTP clients must send; prefer the env var, flags are visible to other users (env MCP_GH_AUTH_TOKEN)")
+	tlsCert := flag.String("tls-cert", os.Getenv("MCP_GH_TLS_CERT"), "TLS certificate file, to serve HTTPS (env MCP_GH_TLS_CERT)")
+	tlsKey := flag.String("tls-key", os.Getenv("MCP_GH_TLS_KEY"), "TLS private key file (env MCP_GH_TLS_KEY)")
+	tlsClientCA := flag.String("tls-client-ca", os.Getenv("MCP_GH_TLS_CLIENT_CA"), "CA bundle that client certificates must be signed by, enabling mTLS (env MCP_GH_TLS_CLIENT_CA)")
+	insecureNoAuth := flag.Bool("insecure-no-auth", os.Getenv("MCP_GH_INSECURE_NO_AUTH") == "true", "allow listening beyond localhost without --auth-token or --tls-client-ca, e.g. behind an authenticating proxy (env MCP_GH_INSECURE_NO_AUTH=true)")
+	requireSessionToken := flag.Bool("require-session-token", os.Getenv("MCP_GH_REQUIRE_SESSION_TOKEN") == "true", "refuse gh commands from sessions that did not supply their own GitHub token; always on with the http and sse transports unless --allow-host-login is set (env MCP_GH_REQUIRE_SESSION_TOKEN=true)")
+	allowHostLogin := flag.Bool("allow-host-login", os.Getenv("MCP_GH_ALLOW_HOST_LOGIN") == "true", "let sessions of the http and sse transports that did not supply a GitHub token run gh as the host's login (env MCP_GH_ALLOW_HOST_LOGIN=true)")
+	auditLog := flag.String("audit-log", os.Getenv("MCP_GH_AUDIT_LOG"), "file to append a JSONL record of every tool call to (env MCP_GH_AUDIT_LOG)")
+	auditMaxSize := flag.Int64("audit-max-size", 100, "rotate the audit log once it reaches this many megabytes, 0 to never rotate (env MCP_GH_AUDIT_MAX_SIZE)")
+	auditMaxBackups := flag.Int("audit-max-backups", 5, "number of rotated audit log files to keep (env MCP_GH_AUDIT_MAX_BACKUPS)")
+	auditHashChain := flag.Bool("audit-hash-chain", os.Getenv("MCP_GH_AUDIT_HASH_CHAIN") == "true", "link each audit record to the previous one by hash, so that tampering can be detected (env MCP_GH_AUDIT_HASH_CHAIN=true)")
+	for name, env := range map[string]string{
+		"audit-max-size":    "MCP_GH_AUDIT_MAX_SIZE",
+		"audit-max-backups": "MCP_GH_AUDIT_MAX_BACKUPS",
+	} {
+		if err := flagFromEnv(name, env); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+	}
+	flag.Parse()
+
 	// Set up structured logging to stderr only (stdout is reserved for MCP protocol)
 	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
 		Level: slog.LevelInfo,
@@ -20,6 +69,22 @@ func main() {
 
 	logger.Info("starting mcp-go-gh server")
 
+	httpConfig := httpserver.Config{
+		Transport:      *transport,
+		Addr:           *listen,
+		AuthToken:      *authToken,
+		TLSCertFile:    *tlsCert,
+		TLSKeyFile:     *tlsKey,
+		ClientCAFile:   *tlsClientCA,
+		InsecureNoAuth: *insecureNoAuth,
+	}
+	if *transport != httpserver.TransportStdio {
+		if err := httpConfig.Validate(); err != nil {
+			logger.Error("invalid transport configuration", "error", err)
+			os.Exit(1)
+		}
+	}
+
 	// Create executor for running gh CLI commands
 	exec, err := executor.New(logger)
 	if err != nil {
@@ -27,7 +92,62 @@ func main() {
 		os.Exit(1)
 	}
 
-	logger.Info("initialized gh CLI executor", "gh_path", exec.GetGhPath())
+	if *defaultTimeout <= 0 {
+		logger.Error("invalid default timeout", "default_timeout", *defaultTimeout)
+		os.Exit(1)
+	}
+	exec.SetTimeout(*defaultTimeout)
+	exec.SetMaxTimeout(*maxTimeout)
+
+	if *workdir != "" {
+		if info, err := os.Stat(*workdir); err != nil || !info.IsDir() {
+			logger.Error("invalid workdir", "workdir", *workdir, "error", err)
+			os.Exit(1)
+		}
+		exec.SetWorkdir(*workdir)
+	}
+
+	redactionMode, err := executor.ParseRedactionMode(*redaction)
+	if err != nil {
+		logger.Error("invalid redaction mode", "error", err)
+		os.Exit(1)
+	}
+	exec.SetRedactionMode(redactionMode)
+	if len(redactPatterns) > 0 {
+		patterns, err := executor.ParseRedactionPatterns(redactPatterns)
+		if err != nil {
+			logger.Error("invalid redaction pattern", "error", err)
+			os.Exit(1)
+		}
+		rules := executor.DefaultRedactionRules()
+		rules.Patterns = append(rules.Patterns, patterns...)
+		exec.SetRedactor(executor.NewRedactor(rules))
+	}
+	exec.SetReadOnly(*readOnly)
+	exec.SetConfirmDestructive(*confirmDestructive)
+
+	// Remote clients must not act as the host's gh login unless the
+	// operator says so.
+	if *requireSessionToken && *allowHostLogin {
+		logger.Error("--require-session-token and --allow-host-login conflict")
+		os.Exit(1)
+	}
+	requireCredentials := *requireSessionToken || (*transport != httpserver.TransportStdio && !*allowHostLogin)
+	exec.SetRequireCredentials(requireCredentials)
+
+	// Middleware around the gh command of every tool
+	exec.Use(executor.LogToolCalls(logger))
+
+	logger.Info("initialized gh CLI executor",
+		"gh_path", exec.GetGhPath(),
+		"default_timeout", *defaultTimeout,
+		"max_timeout", *maxTimeout,
+		"workdir", *workdir,
+		"redaction", redactionMode,
+		"redact_patterns", len(redactPatterns),
+		"read_only", *readOnly,
+		"confirm_destructive", *confirmDestructive,
+		"require_session_token", requireCredentials)
 
 	// Create MCP server
 	impl := &mcp.Implementation{
@@ -40,19 +160,79 @@ func main() {
 
 	logger.Info("created MCP server", "name", "mcp-go-gh", "version", "1.0.0")
 
-	// Register all generated gh command tools
-	generated.RegisterAllTools(server, exec)
-	logger.Info("registered all tools successfully")
+	if *auditLog != "" {
+		log, err := audit.Open(audit.Options{
+			Path:       *auditLog,
+			MaxSize:    *auditMaxSize << 20,
+			MaxBackups: *auditMaxBackups,
+			HashChain:  *auditHashChain,
+		})
+		if err != nil {
+			logger.Error("failed to open audit log", "error", err)
+			os.Exit(1)
+		}
+		defer log.Close()
+		server.AddReceivingMiddleware(log.Middleware(logger, exec.RedactArgs))
+		// Added last, so that it records the command that actually runs.
+		exec.Use(log.ToolMiddleware(exec.RedactArgs))
+		logger.Info("auditing tool calls",
+			"audit_log", *auditLog,
+			"max_size_mb", *auditMaxSize,
+			"max_backups", *auditMaxBackups,
+			"hash_chain", *auditHashChain)
+	}
+
+	// Register the selected tools
+	selection := toolset.Selection{
+		Toolsets:        toolset.ParseList(*toolsets),
+		ExcludeToolsets: toolset.ParseList(*excludeToolsets),
+		Tools:           toolset.ParseList(*tools),
+		ExcludeTools:    toolset.ParseList(*excludeTools),
+		ReadOnly:        *readOnly,
+	}
+	available := append(generated.Toolsets, session.Toolset)
+	if err := selection.Validate(available); err != nil {
+		logger.Error("invalid tool selection", "error", err)
+		os.Exit(1)
+	}
+	registered := toolset.Register(server, exec, available, selection)
+	if len(registered) == 0 {
+		logger.Error("tool selection matches no tools")
+		os.Exit(1)
+	}
+	logger.Info("registered tools successfully", "count", len(registered))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	// Create stdio transport for communication
-	transport := &mcp.StdioTransport{}
+	if *transport != httpserver.TransportStdio {
+		if err := httpserver.Serve(ctx, server, httpConfig, logger); err != nil {
+			logger.Error("server error", "error", err)
+			os.Exit(1)
+		}
+		logger.Info("server stopped")
+		return
+	}
 
-	// Start the server
+	// Start the server on stdio
 	logger.Info("starting MCP server with stdio transport")
-	if err := server.Run(context.Background(), transport); err != nil {
+	if err := server.Run(ctx, &mcp.StdioTransport{}); err != nil {
 		logger.Error("server error", "error", err)
 		os.Exit(1)
 	}
 
 	logger.Info("server stopped")
 }
+
+// flagFromEnv sets the named flag from the environment variable env, if it
+// is set, so that values on the command line still take precedence.
+func flagFromEnv(name, env string) error {
+	value, ok := os.LookupEnv(env)
+	if !ok {
+		return nil
+	}
+	if err := flag.Set(name, value); err != nil {
+		return fmt.Errorf("invalid value %q for %s: %w", value, env, err)
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+// Package audit writes a JSONL record of every tool call, for reviewing what
+// clients did through the server.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Record is the audit entry of one tool call.
+type Record struct {
+	Time      time.Time `json:"time"`
+	SessionID string    `json:"session_id,omitempty"`
+	Client    string    `json:"client,omitempty"`
+	Tool      string    `json:"tool"`
+
+	// Argv is the gh command line with secret values redacted, empty when
+	// the call ran no command.
+	Argv     string `json:"argv,omitempty"`
+	Cwd      string `json:"cwd,omitempty"`
+	ExitCode int    `json:"exit_code"`
+
+	DurationMS  int64  `json:"duration_ms"`
+	OutputBytes int    `json:"output_bytes"`
+	ErrorKind   string `json:"error_kind,omitempty"`
+
+	// PrevHash and Hash chain records together when hash chaining is on:
+	// Hash covers the record including PrevHash, the Hash of the record
+	// before it.
+	PrevHash string `json:"prev_hash,omitempty"`
+	Hash     string `json:"hash,omitempty"`
+}
+
+// Options configures a Log.
+type Options struct {
+	// Path is the file records are appended to.
+	Path string
+
+	// MaxSize rotates the file once it would grow beyond this many bytes.
+	// Zero disables rotation.
+	MaxSize int64
+
+	// MaxBackups is how many rotated files (Path.1, Path.2, ...) are kept.
+	MaxBackups int
+
+	// HashChain links every record to the one before it, so that removed or
+	// altered records can be detected with Verify.
+	HashChain bool
+}
+
+// Log appends audit records to a file. It is safe for concurrent use.
+type Log struct {
+	opts Options
+
+	mu       sync.Mutex
+	file     *os.File
+	size     int64
+	lastHash string
+}
+
+// Open opens the audit log at opts.Path, creating it if needed. With hash
+// chaining, the chain continues from the last record already in the log.
+func Open(opts Options) (*Log, error) {
+	l := &Log{opts: opts}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	if opts.HashChain {
+		hash, err := lastHash(opts.Path)
+		if err == nil && hash == "" && opts.MaxBackups > 0 {
+			hash, err = lastHash(backupPath(opts.Path, 1))
+		}
+		if err != nil {
+			_ = l.file.Close()
+			return nil, err
+		}
+		l.lastHash = hash
+	}
+	return l, nil
+}
+
+// Write appends rec to the log, filling in its hashes when hash chaining is
+// on.
+func (l *Log) Write(rec *Record) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	rec.PrevHash, rec.Hash = "", ""
+	if l.opts.HashChain {
+		rec.PrevHash = l.lastHash
+		hash, err := recordHash(rec)
+		if err != nil {
+			return err
+		}
+		rec.Hash = hash
+	}
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("encoding audit record: %w", err)
+	}
+	line = append(line, '\n')
+
+	if l.opts.MaxSize > 0 && l.size > 0 && l.size+int64(len(line)) > l.opts.MaxSize {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := l.file.Write(line)
+	l.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("writing audit record: %w", err)
+	}
+	l.lastHash = rec.Hash
+	return nil
+}
+
+// Close closes the log file.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
+
+// open opens the log file for appending.
+func (l *Log) open() error {
+	// #nosec G304 -- the path comes from the server's own configuration
+	file, err := os.OpenFile(l.opts.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening audit log: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("opening audit log: %w", err)
+	}
+	l.file, l.size = file, info.Size()
+	return nil
+}
+
+// rotate moves the log file to Path.1, shifting older backups up and
+// dropping the oldest, and starts a new file.
+func (l *Log) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("rotating audit log: %w", err)
+	}
+	if l.opts.MaxBackups > 0 {
+		_ = os.Remove(backupPath(l.opts.Path, l.opts.MaxBackups))
+		for i := l.opts.MaxBackups - 1; i >= 1; i-- {
+			if err := os.Rename(backupPath(l.opts.Path, i), backupPath(l.opts.Path, i+1)); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("rotating audit log: %w", err)
+			}
+		}
+		if err := os.Rename(l.opts.Path, backupPath(l.opts.Path, 1)); err != nil {
+			return fmt.Errorf("rotating audit log: %w", err)
+		}
+	} else if err := os.Remove(l.opts.Path); err != nil {
+		return fmt.Errorf("rotating audit log: %w", err)
+	}
+	return l.open()
+}
+
+// backupPath returns the path of the nth rotated file.
+func backupPath(path string, n int) string {
+	return path + "." + strconv.Itoa(n)
+}
+
+// recordHash returns the hash of rec, covering every field but Hash.
+func recordHash(rec *Record) (string, error) {
+	unhashed := *rec
+	unhashed.Hash = ""
+	data, err := json.Marshal(&unhashed)
+	if err != nil {
+		return "", fmt.Errorf("encoding audit record: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// lastHash returns the hash of the last record in the file at path, or ""
+// when there is none.
+func lastHash(path string) (string, error) {
+	// #nosec G304 -- the path comes from the server's own configuration
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("reading audit log: %w", err)
+	}
+	defer file.Close()
+
+	line, err := lastLine(file)
+	if err != nil {
+		return "", fmt.Errorf("reading audit log: %w", err)
+	}
+	if len(line) == 0 {
+		return "", nil
+	}
+	var rec Record
+	if err := json.Unmarshal(line, &rec); err != nil {
+		return "", fmt.Errorf("reading last audit record: %w", err)
+	}
+	return rec.Hash, nil
+}
+
+// lastLineChunk is how much of the end of a file lastLine reads at a time.
+const lastLineChunk = 4096
+
+// lastLine returns the last non-empty line of file, reading backwards from
+// its end so that large logs are not read in full.
+func lastLine(file *os.File) ([]byte, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	var tail []byte
+	for end := info.Size(); end > 0; {
+		start := max(end-lastLineChunk, 0)
+		chunk := make([]byte, end-start)
+		if _, err := file.ReadAt(chunk, start); err != nil {
+			return nil, err
+		}
+		tail = append(chunk, tail...)
+		end = start
+
+		trimmed := bytes.TrimRight(tail, "\n")
+		if i := bytes.LastIndexByte(trimmed, '\n'); i >= 0 {
+			return trimmed[i+1:], nil
+		}
+		if end == 0 {
+			return trimmed, nil
+		}
+	}
+	return nil, nil
+}
+
+// Verify checks the hash chain of the records read from r, returning the
+// Hash of the last record and the number of records checked. prevHash is the
+// Hash of the record before the first one, or "" when r starts the chain.
+// To verify rotated files, check them oldest first, passing each file's last
+// Hash on to the next.
+func Verify(r io.Reader, prevHash string) (last string, n int, err error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		n++
+		var rec Record
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			return prevHash, n, fmt.Errorf("record %d: %w", n, err)
+		}
+		if rec.PrevHash != prevHash {
+			return prevHash, n, fmt.Errorf("record %d: chain broken: prev_hash %q, want %q", n, rec.PrevHash, prevHash)
+		}
+		hash, err := recordHash(&rec)
+		if err != nil {
+			return prevHash, n, fmt.Errorf("record %d: %w", n, err)
+		}
+		if rec.Hash != hash {
+			return prevHash, n, fmt.Errorf("record %d: hash mismatch: record was altered", n)
+		}
+		prevHash = rec.Hash
+	}
+	return prevHash, n, scanner.Err()
+}
@@ -0,0 +1,176 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLog_Write(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	log, err := Open(Options{Path: path})
+	require.NoError(t, err)
+
+	when := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, log.Write(&Record{Time: when, SessionID: "s1", Client: "claude", Tool: "gh_pr_list", Argv: "pr list", ExitCode: 0, DurationMS: 12, OutputBytes: 34}))
+	require.NoError(t, log.Write(&Record{Time: when, Tool: "gh_repo_delete", Argv: "repo delete o/r", ExitCode: 1, ErrorKind: "not_found"}))
+	require.NoError(t, log.Close())
+
+	records := readRecords(t, path)
+	require.Len(t, records, 2)
+	assert.Equal(t, Record{Time: when, SessionID: "s1", Client: "claude", Tool: "gh_pr_list", Argv: "pr list", DurationMS: 12, OutputBytes: 34}, records[0])
+	assert.Equal(t, "not_found", records[1].ErrorKind)
+	assert.Empty(t, records[1].Hash, "records are not hashed without hash chaining")
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestLog_Rotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	log, err := Open(Options{Path: path, MaxSize: 200, MaxBackups: 2})
+	require.NoError(t, err)
+	defer log.Close()
+
+	for i := range 10 {
+		require.NoError(t, log.Write(&Record{Tool: "gh_tool_" + strconv.Itoa(i)}))
+	}
+
+	for _, file := range []string{path, path + ".1", path + ".2"} {
+		info, err := os.Stat(file)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, info.Size(), int64(200), file)
+	}
+	assert.NoFileExists(t, path+".3", "only MaxBackups rotated files are kept")
+
+	records := readRecords(t, path)
+	assert.Equal(t, "gh_tool_9", records[len(records)-1].Tool, "the newest record is in the current file")
+}
+
+func TestLog_HashChain(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	log, err := Open(Options{Path: path, MaxSize: 300, MaxBackups: 5, HashChain: true})
+	require.NoError(t, err)
+	for _, tool := range []string{"gh_pr_list", "gh_pr_view", "gh_pr_merge"} {
+		require.NoError(t, log.Write(&Record{Tool: tool}))
+	}
+	require.NoError(t, log.Close())
+
+	// Reopening continues the chain.
+	log, err = Open(Options{Path: path, MaxSize: 300, MaxBackups: 5, HashChain: true})
+	require.NoError(t, err)
+	require.NoError(t, log.Write(&Record{Tool: "gh_repo_delete"}))
+	require.NoError(t, log.Close())
+
+	t.Run("verifies across rotated files", func(t *testing.T) {
+		files, _ := filepath.Glob(path + ".*")
+		require.NotEmpty(t, files, "the log was rotated")
+
+		prev, total := "", 0
+		for i := len(files); i >= 1; i-- {
+			prev, total = verifyFile(t, backupPath(path, i), prev, total)
+		}
+		_, total = verifyFile(t, path, prev, total)
+		assert.Equal(t, 4, total)
+	})
+
+	t.Run("detects altered records", func(t *testing.T) {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		altered := strings.Replace(string(data), "gh_repo_delete", "gh_repo_view__", 1)
+
+		var rec Record
+		require.NoError(t, json.Unmarshal([]byte(strings.SplitN(altered, "\n", 2)[0]), &rec))
+		_, _, err = Verify(strings.NewReader(altered), rec.PrevHash)
+		assert.ErrorContains(t, err, "hash mismatch")
+	})
+
+	t.Run("detects removed records", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.jsonl")
+		log, err := Open(Options{Path: path, HashChain: true})
+		require.NoError(t, err)
+		for _, tool := range []string{"gh_pr_list", "gh_pr_merge", "gh_pr_view"} {
+			require.NoError(t, log.Write(&Record{Tool: tool}))
+		}
+		require.NoError(t, log.Close())
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		lines := strings.SplitAfter(string(data), "\n")
+		_, _, err = Verify(strings.NewReader(lines[0]+lines[2]), "")
+		assert.ErrorContains(t, err, "record 2: chain broken")
+	})
+}
+
+func TestLastHash(t *testing.T) {
+	dir := t.TempDir()
+
+	hash, err := lastHash(filepath.Join(dir, "missing.jsonl"))
+	require.NoError(t, err)
+	assert.Empty(t, hash, "a missing log has no last record")
+
+	empty := filepath.Join(dir, "empty.jsonl")
+	require.NoError(t, os.WriteFile(empty, nil, 0o600))
+	hash, err = lastHash(empty)
+	require.NoError(t, err)
+	assert.Empty(t, hash)
+
+	// Records longer than a chunk, and a log spanning many chunks.
+	var log strings.Builder
+	for i := range 3 * lastLineChunk / 100 {
+		fmt.Fprintf(&log, `{"tool":"gh_pr_list","hash":"h%d"}`+"\n", i)
+	}
+	fmt.Fprintf(&log, `{"tool":"%s","hash":"last"}`+"\n\n", strings.Repeat("x", 2*lastLineChunk))
+	path := filepath.Join(dir, "audit.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(log.String()), 0o600))
+	hash, err = lastHash(path)
+	require.NoError(t, err)
+	assert.Equal(t, "last", hash)
+
+	single := filepath.Join(dir, "single.jsonl")
+	require.NoError(t, os.WriteFile(single, []byte(`{"tool":"gh_pr_list","hash":"only"}`), 0o600))
+	hash, err = lastHash(single)
+	require.NoError(t, err)
+	assert.Equal(t, "only", hash, "the last record may lack a newline")
+}
+
+// readRecords returns the records in the file at path.
+func readRecords(t *testing.T, path string) []Record {
+	t.Helper()
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var rec Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &rec))
+		records = append(records, rec)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+// verifyFile verifies the chain of the file at path following prev, and
+// returns its last hash and the running record count.
+func verifyFile(t *testing.T, path, prev string, total int) (string, int) {
+	t.Helper()
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	last, n, err := Verify(file, prev)
+	require.NoError(t, err, path)
+	return last, total + n
+}
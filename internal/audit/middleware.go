@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+)
+
+// errorKindUnknown is recorded for failed calls that report no error kind.
+const errorKindUnknown = "unknown"
+
+// recordKey is the context key of the Record of the tool call in progress.
+type recordKey struct{}
+
+// Middleware returns server middleware that writes a record of every tool
+// call to l. The command a tool attempted, formatted by redact, which must
+// remove secrets, and its directory are recorded even when it never ran,
+// for example because the user declined it; the command that ran is filled
+// in by ToolMiddleware. Failures to write a record are logged, and do not
+// fail the call.
+func (l *Log) Middleware(logger *slog.Logger, redact func(argv []string) string) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			params, ok := req.GetParams().(*mcp.CallToolParamsRaw)
+			if method != "tools/call" || !ok {
+				return next(ctx, method, req)
+			}
+
+			start := time.Now()
+			rec := &Record{Time: start.UTC(), Tool: params.Name}
+			if ss, ok := req.GetSession().(*mcp.ServerSession); ok {
+				rec.SessionID = ss.ID()
+				if init := ss.InitializeParams(); init != nil && init.ClientInfo != nil {
+					rec.Client = init.ClientInfo.Name
+				}
+			}
+
+			ctx = context.WithValue(ctx, recordKey{}, rec)
+			ctx = executor.WithAttemptObserver(ctx, func(argv []string, dir string) {
+				rec.Argv = redact(argv)
+				rec.Cwd = dir
+			})
+			result, err := next(ctx, method, req)
+
+			rec.DurationMS = time.Since(start).Milliseconds()
+			rec.ErrorKind = errorKind(result, err)
+			if werr := l.Write(rec); werr != nil {
+				logger.Error("failed to write audit record", "tool", rec.Tool, "error", werr)
+			}
+			return result, err
+		}
+	}
+}
+
+// ToolMiddleware returns executor middleware that notes the gh command of
+// each tool call audited by Middleware: its argv formatted by redact, which
+// must remove secrets, whether or not the runner ran it, and once it has
+// run, the directory it ran in, its exit code and output size. Add it after
+// any middleware that rewrites argv, so that it records the command that
+// actually ran.
+func (l *Log) ToolMiddleware(redact func(argv []string) string) executor.ToolMiddleware {
+	return func(next executor.ToolHandler) executor.ToolHandler {
+		return func(ctx context.Context, tool string, argv []string) (*executor.Result, error) {
+			rec, ok := ctx.Value(recordKey{}).(*Record)
+			if ok {
+				rec.Argv = redact(argv)
+			}
+			result, err := next(ctx, tool, argv)
+			if ok && result != nil {
+				if result.Dir != "" {
+					rec.Cwd = result.Dir
+				}
+				rec.ExitCode = result.ExitCode
+				rec.OutputBytes = len(result.Stdout) + len(result.Stderr)
+			}
+			return result, err
+		}
+	}
+}
+
+// errorKind returns the kind of error a tool call ended with, or "" when it
+// succeeded.
+func errorKind(result mcp.Result, err error) string {
+	if err != nil {
+		return errorKindUnknown
+	}
+	toolResult, ok := result.(*mcp.CallToolResult)
+	if !ok || !toolResult.IsError {
+		return ""
+	}
+	data, err := json.Marshal(toolResult.StructuredContent)
+	if err != nil {
+		return errorKindUnknown
+	}
+	var toolError struct {
+		Kind string `json:"kind"`
+	}
+	if json.Unmarshal(data, &toolError) != nil || toolError.Kind == "" {
+		return errorKindUnknown
+	}
+	return toolError.Kind
+}
@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/executor/executortest"
+)
+
+func TestMiddleware(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	log, err := Open(Options{Path: path, HashChain: true})
+	require.NoError(t, err)
+
+	redact := func(argv []string) string { return strings.ReplaceAll(strings.Join(argv, " "), "s3cr3t", "[REDACTED]") }
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	server.AddReceivingMiddleware(log.Middleware(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})), redact))
+	runner := executortest.NewRunner()
+	runner.Respond("gh_pr_list", executortest.Response{Result: &executor.Result{Stdout: "#1  Bug\n", Dir: "/work"}})
+	runner.Respond("gh_pr_view", executortest.Response{
+		Result: &executor.Result{Stderr: "not found", ExitCode: 1, Dir: "/work"},
+		Err:    errors.New("gh command failed (exit 1)"),
+	})
+	runner.Respond("gh_repo_delete", executortest.Response{Err: executor.ErrNoCredentials})
+	run := log.ToolMiddleware(redact)(
+		func(ctx context.Context, tool string, argv []string) (*executor.Result, error) {
+			return runner.Run(ctx, tool, executor.Options{}, argv...)
+		})
+
+	mcp.AddTool(server, &mcp.Tool{Name: "gh_pr_list"}, func(ctx context.Context, _ *mcp.CallToolRequest, _ struct{}) (*mcp.CallToolResult, any, error) {
+		_, err := run(ctx, "gh_pr_list", []string{"pr", "list", "--search", "s3cr3t"})
+		require.NoError(t, err)
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "ok"}}}, nil, nil
+	})
+	mcp.AddTool(server, &mcp.Tool{Name: "gh_pr_view"}, func(ctx context.Context, _ *mcp.CallToolRequest, _ struct{}) (*mcp.CallToolResult, any, error) {
+		_, err := run(ctx, "gh_pr_view", []string{"pr", "view", "99"})
+		require.Error(t, err)
+		return &mcp.CallToolResult{IsError: true, StructuredContent: map[string]any{"kind": "not_found"}}, nil, nil
+	})
+	mcp.AddTool(server, &mcp.Tool{Name: "gh_repo_delete"}, func(ctx context.Context, _ *mcp.CallToolRequest, _ struct{}) (*mcp.CallToolResult, any, error) {
+		argv := []string{"repo", "delete", "octo/s3cr3t", "--yes"}
+		executor.Attempt(ctx, argv, "/work")
+		_, err := run(ctx, "gh_repo_delete", argv)
+		require.ErrorIs(t, err, executor.ErrNoCredentials)
+		return &mcp.CallToolResult{IsError: true, StructuredContent: map[string]any{"kind": "auth_required"}}, nil, nil
+	})
+	mcp.AddTool(server, &mcp.Tool{Name: "gh_label_delete"}, func(ctx context.Context, _ *mcp.CallToolRequest, _ struct{}) (*mcp.CallToolResult, any, error) {
+		executor.Attempt(ctx, []string{"label", "delete", "bug", "--yes"}, "/work")
+		return &mcp.CallToolResult{IsError: true, StructuredContent: map[string]any{"kind": "declined"}}, nil, nil
+	})
+	mcp.AddTool(server, &mcp.Tool{Name: "gh_broken"}, func(context.Context, *mcp.CallToolRequest, struct{}) (*mcp.CallToolResult, any, error) {
+		return nil, nil, errors.New("broken")
+	})
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	defer serverSession.Close()
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	cs, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	defer cs.Close()
+
+	_, err = cs.ListTools(ctx, nil)
+	require.NoError(t, err)
+	for _, tool := range []string{"gh_pr_list", "gh_pr_view", "gh_repo_delete", "gh_label_delete", "gh_broken"} {
+		_, err := cs.CallTool(ctx, &mcp.CallToolParams{Name: tool})
+		require.NoError(t, err)
+	}
+	require.NoError(t, log.Close())
+
+	records := readRecords(t, path)
+	require.Len(t, records, 5, "only tool calls are audited")
+
+	list := records[0]
+	assert.Equal(t, "gh_pr_list", list.Tool)
+	assert.Equal(t, "test-client", list.Client)
+	assert.Equal(t, "pr list --search [REDACTED]", list.Argv)
+	assert.Equal(t, "/work", list.Cwd)
+	assert.Equal(t, 0, list.ExitCode)
+	assert.Equal(t, len("#1  Bug\n"), list.OutputBytes)
+	assert.Empty(t, list.ErrorKind)
+	assert.False(t, list.Time.IsZero())
+	assert.NotEmpty(t, list.Hash)
+
+	view := records[1]
+	assert.Equal(t, "pr view 99", view.Argv)
+	assert.Equal(t, 1, view.ExitCode)
+	assert.Equal(t, "not_found", view.ErrorKind)
+	assert.Equal(t, list.Hash, view.PrevHash)
+
+	refused := records[2]
+	assert.Equal(t, "repo delete octo/[REDACTED] --yes", refused.Argv, "commands the runner refuses are recorded")
+	assert.Equal(t, "/work", refused.Cwd)
+	assert.Equal(t, "auth_required", refused.ErrorKind)
+
+	declined := records[3]
+	assert.Equal(t, "label delete bug --yes", declined.Argv, "commands that never reach the runner are recorded")
+	assert.Equal(t, "/work", declined.Cwd)
+	assert.Equal(t, "declined", declined.ErrorKind)
+
+	broken := records[4]
+	assert.Empty(t, broken.Argv, "no command ran")
+	assert.Equal(t, "unknown", broken.ErrorKind)
+}
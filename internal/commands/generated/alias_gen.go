@@ -2,34 +2,67 @@
 package generated
 
 import (
+	"cmp"
 	"context"
-	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // AliasListArgs defines parameters for gh alias list
 type AliasListArgs struct {
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// aliasListInputSpec holds the argument constraints for gh alias list
+var aliasListInputSpec = toolkit.InputSpec{}
+
 // RegisterAliasListTool registers the gh alias list tool
-func RegisterAliasListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterAliasListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_alias_list",
 		Description: "List your aliases",
+		InputSchema: toolkit.InputSchema[AliasListArgs](aliasListInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Aliases",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(false),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args AliasListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh alias list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh alias list", nil, err)), nil, nil
+		}
+
 		cmd := []string{"alias", "list"}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_alias_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh alias list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh alias list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -40,14 +73,44 @@ type AliasSetArgs struct {
 
 	Alias     string `json:"alias,omitempty" jsonschema:"Alias name (positional argument)"`
 	Expansion string `json:"expansion,omitempty" jsonschema:"Expansion string (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// aliasSetInputSpec holds the argument constraints for gh alias set
+var aliasSetInputSpec = toolkit.InputSpec{
+	Required: []string{"alias", "expansion"},
 }
 
 // RegisterAliasSetTool registers the gh alias set tool
-func RegisterAliasSetTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterAliasSetTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_alias_set",
 		Description: "Create a shortcut for a gh command",
+		InputSchema: toolkit.InputSchema[AliasSetArgs](aliasSetInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Set Alias",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(false),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args AliasSetArgs) (*mcp.CallToolResult, any, error) {
+		if err := aliasSetInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh alias set", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh alias set", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh alias set", nil, err)), nil, nil
+		}
+
 		cmd := []string{"alias", "set"}
 
 		// Add positional argument: alias
@@ -68,16 +131,23 @@ func RegisterAliasSetTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--shell")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_alias_set", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh alias set failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh alias set", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -86,14 +156,40 @@ type AliasDeleteArgs struct {
 	All bool `json:"all,omitempty" jsonschema:"Delete all aliases"`
 
 	Alias string `json:"alias,omitempty" jsonschema:"Alias name to delete (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
 }
 
+// aliasDeleteInputSpec holds the argument constraints for gh alias delete
+var aliasDeleteInputSpec = toolkit.InputSpec{}
+
 // RegisterAliasDeleteTool registers the gh alias delete tool
-func RegisterAliasDeleteTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterAliasDeleteTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_alias_delete",
 		Description: "Delete set aliases",
+		InputSchema: toolkit.InputSchema[AliasDeleteArgs](aliasDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete Alias",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(false),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args AliasDeleteArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh alias delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh alias delete", nil, err)), nil, nil
+		}
+
 		cmd := []string{"alias", "delete"}
 
 		// Add positional argument: alias
@@ -105,16 +201,29 @@ func RegisterAliasDeleteTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--all")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh alias delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_alias_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh alias delete failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh alias delete", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -123,14 +232,39 @@ type AliasImportArgs struct {
 	Clobber bool `json:"clobber,omitempty" jsonschema:"Overwrite existing aliases of the same name"`
 
 	Filename string `json:"filename,omitempty" jsonschema:"Path to YAML file containing aliases (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// aliasImportInputSpec holds the argument constraints for gh alias import
+var aliasImportInputSpec = toolkit.InputSpec{}
+
 // RegisterAliasImportTool registers the gh alias import tool
-func RegisterAliasImportTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterAliasImportTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_alias_import",
 		Description: "Import aliases from a YAML file",
+		InputSchema: toolkit.InputSchema[AliasImportArgs](aliasImportInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Import Aliases",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(false),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args AliasImportArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh alias import", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh alias import", nil, err)), nil, nil
+		}
+
 		cmd := []string{"alias", "import"}
 
 		// Add positional argument: filename
@@ -142,15 +276,30 @@ func RegisterAliasImportTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--clobber")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_alias_import", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh alias import failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh alias import", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterAliasTools registers the gh alias tools
+func RegisterAliasTools(server *mcp.Server, exec executor.Runner) {
+	RegisterAliasListTool(server, exec)
+	RegisterAliasSetTool(server, exec)
+	RegisterAliasDeleteTool(server, exec)
+	RegisterAliasImportTool(server, exec)
+}
@@ -0,0 +1,15 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestAliasArgv calls every gh alias tool with representative
+// arguments and compares the commands they run with testdata/alias.golden
+func TestAliasArgv(t *testing.T) {
+	checkArgvGolden(t, "alias", []argvCase{
+		{Tool: "gh_alias_list", Args: map[string]any{}},
+		{Tool: "gh_alias_set", Args: map[string]any{"alias": "sample-alias", "expansion": "sample-expansion", "clobber": true, "shell": true}},
+		{Tool: "gh_alias_delete", Args: map[string]any{"alias": "sample-alias", "all": true}},
+		{Tool: "gh_alias_import", Args: map[string]any{"filename": "sample-filename", "clobber": true}},
+	})
+}
@@ -2,40 +2,86 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"maps"
+	"slices"
 )
 
-// ApiRequestArgs defines parameters for gh api request
+// ApiRequestArgs defines parameters for gh api
 type ApiRequestArgs struct {
-	Method   string            `json:"method,omitempty" jsonschema:"The HTTP method for the request"`
-	Field    map[string]string `json:"field,omitempty" jsonschema:"Add typed parameter in key=value format (supports @file)"`
-	RawField map[string]string `json:"raw_field,omitempty" jsonschema:"Add string parameter in key=value format"`
-	Header   map[string]string `json:"header,omitempty" jsonschema:"Add HTTP request header in key:value format"`
-	Input    string            `json:"input,omitempty" jsonschema:"The file to use as body for the HTTP request"`
-	Include  bool              `json:"include,omitempty" jsonschema:"Include HTTP response status line and headers"`
-	Silent   bool              `json:"silent,omitempty" jsonschema:"Do not print the response body"`
-	Jq       string            `json:"jq,omitempty" jsonschema:"Filter JSON response using jq expression"`
-	Template string            `json:"template,omitempty" jsonschema:"Format JSON response using Go template"`
-	Paginate bool              `json:"paginate,omitempty" jsonschema:"Make additional HTTP requests to fetch all pages"`
-	Slurp    bool              `json:"slurp,omitempty" jsonschema:"Use with --paginate to return array of all results"`
-	Cache    string            `json:"cache,omitempty" jsonschema:"Cache the response for a duration"`
-	Preview  []string          `json:"preview,omitempty" jsonschema:"GitHub API preview names to opt into"`
-	Hostname string            `json:"hostname,omitempty" jsonschema:"GitHub hostname for Enterprise"`
-	Verbose  bool              `json:"verbose,omitempty" jsonschema:"Include full HTTP request and response"`
+	Method    string            `json:"method,omitempty" jsonschema:"The HTTP method for the request"`
+	Field     map[string]string `json:"field,omitempty" jsonschema:"Add typed parameter in key=value format (supports @file)"`
+	RawField  map[string]string `json:"raw_field,omitempty" jsonschema:"Add string parameter in key=value format"`
+	Header    map[string]string `json:"header,omitempty" jsonschema:"Add HTTP request header in key:value format"`
+	Input     string            `json:"input,omitempty" jsonschema:"The file to use as body for the HTTP request"`
+	InputBody string            `json:"input_body,omitempty" jsonschema:"Request body, sent to gh on standard input instead of a file"`
+	Include   bool              `json:"include,omitempty" jsonschema:"Include HTTP response status line and headers"`
+	Silent    bool              `json:"silent,omitempty" jsonschema:"Do not print the response body"`
+	Jq        string            `json:"jq,omitempty" jsonschema:"Filter JSON response using jq expression"`
+	Template  string            `json:"template,omitempty" jsonschema:"Format JSON response using Go template"`
+	Paginate  bool              `json:"paginate,omitempty" jsonschema:"Make additional HTTP requests to fetch all pages"`
+	Slurp     bool              `json:"slurp,omitempty" jsonschema:"Use with --paginate to return array of all results"`
+	Cache     string            `json:"cache,omitempty" jsonschema:"Cache the response for a duration"`
+	Preview   []string          `json:"preview,omitempty" jsonschema:"GitHub API preview names to opt into"`
+	Hostname  string            `json:"hostname,omitempty" jsonschema:"GitHub hostname for Enterprise"`
+	Verbose   bool              `json:"verbose,omitempty" jsonschema:"Include full HTTP request and response"`
 
 	Endpoint string `json:"endpoint,omitempty" jsonschema:"The API endpoint path or GraphQL query (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// apiRequestInputSpec holds the argument constraints for gh api
+var apiRequestInputSpec = toolkit.InputSpec{
+	Required: []string{"endpoint"},
+	Properties: map[string]toolkit.Property{
+		"method": {Enum: []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD"}},
+	},
 }
 
-// RegisterApiRequestTool registers the gh api request tool
-func RegisterApiRequestTool(server *mcp.Server, exec *executor.Executor) {
+// RegisterApiRequestTool registers the gh api tool
+func RegisterApiRequestTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_api_request",
 		Description: "Make an authenticated HTTP request to the GitHub API and print the response",
+		InputSchema: toolkit.InputSchema[ApiRequestArgs](apiRequestInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "GitHub API Request",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ApiRequestArgs) (*mcp.CallToolResult, any, error) {
-		cmd := []string{"api", "request"}
+		if err := apiRequestInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh api", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Hostname: args.Hostname, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh api", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh api", nil, err)), nil, nil
+		}
+
+		if exec.ReadOnly() {
+			if args.Method, err = toolkit.ReadOnlyArg("method", args.Method, "GET"); err != nil {
+				return defaults.Annotate(toolkit.ValidationErrorResult("gh api", err)), nil, nil
+			}
+		}
+
+		cmd := []string{"api"}
 
 		// Add positional argument: endpoint
 		if args.Endpoint != "" {
@@ -46,22 +92,26 @@ func RegisterApiRequestTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--method", args.Method)
 		}
 
-		for k, v := range args.Field {
-			cmd = append(cmd, "-F", fmt.Sprintf("%s=%s", k, v))
+		for _, k := range slices.Sorted(maps.Keys(args.Field)) {
+			cmd = append(cmd, "-F", fmt.Sprintf("%s=%s", k, args.Field[k]))
 		}
 
-		for k, v := range args.RawField {
-			cmd = append(cmd, "-f", fmt.Sprintf("%s=%s", k, v))
+		for _, k := range slices.Sorted(maps.Keys(args.RawField)) {
+			cmd = append(cmd, "-f", fmt.Sprintf("%s=%s", k, args.RawField[k]))
 		}
 
-		for k, v := range args.Header {
-			cmd = append(cmd, "-H", fmt.Sprintf("%s=%s", k, v))
+		for _, k := range slices.Sorted(maps.Keys(args.Header)) {
+			cmd = append(cmd, "-H", fmt.Sprintf("%s:%s", k, args.Header[k]))
 		}
 
 		if args.Input != "" {
 			cmd = append(cmd, "--input", args.Input)
 		}
 
+		if args.InputBody != "" {
+			cmd = append(cmd, "--input=-")
+		}
+
 		if args.Include {
 			cmd = append(cmd, "--include")
 		}
@@ -102,15 +152,34 @@ func RegisterApiRequestTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--verbose")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() && (!slices.Contains([]string{"GET", "HEAD"}, args.Method)) {
+			if result := toolkit.Confirm(ctx, req, "gh api", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, args.InputBody); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_api_request", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+			Stdin:       args.InputBody,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh api request failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh api", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterApiTools registers the gh api tools
+func RegisterApiTools(server *mcp.Server, exec executor.Runner) {
+	RegisterApiRequestTool(server, exec)
+}
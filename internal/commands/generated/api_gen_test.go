@@ -0,0 +1,12 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestApiArgv calls every gh api tool with representative
+// arguments and compares the commands they run with testdata/api.golden
+func TestApiArgv(t *testing.T) {
+	checkArgvGolden(t, "api", []argvCase{
+		{Tool: "gh_api_request", Args: map[string]any{"endpoint": "sample-endpoint", "method": "GET", "field": map[string]any{"key": "value"}, "raw_field": map[string]any{"key": "value"}, "header": map[string]any{"key": "value"}, "input": "sample-input", "input_body": "sample-input-body", "include": true, "silent": true, "jq": "sample-jq", "template": "sample-template", "paginate": true, "slurp": true, "cache": "sample-cache", "preview": []any{"sample-preview"}, "hostname": "sample-hostname", "verbose": true}},
+	})
+}
@@ -2,9 +2,12 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -26,14 +29,47 @@ type AttestationVerifyArgs struct {
 	SignerWorkflow        string `json:"signer_workflow,omitempty" jsonschema:"Path to reusable workflow that signed attestation"`
 
 	Artifact string `json:"artifact,omitempty" jsonschema:"File path or OCI URI of artifact to verify (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// attestationVerifyInputSpec holds the argument constraints for gh attestation verify
+var attestationVerifyInputSpec = toolkit.InputSpec{
+	Required: []string{"artifact"},
+	Properties: map[string]toolkit.Property{
+		"digest_alg": {Enum: []string{"sha256", "sha512"}},
+	},
 }
 
 // RegisterAttestationVerifyTool registers the gh attestation verify tool
-func RegisterAttestationVerifyTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterAttestationVerifyTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_attestation_verify",
 		Description: "Verify the integrity and provenance of an artifact using attestations",
+		InputSchema: toolkit.InputSchema[AttestationVerifyArgs](attestationVerifyInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Verify Artifact Attestation",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args AttestationVerifyArgs) (*mcp.CallToolResult, any, error) {
+		if err := attestationVerifyInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh attestation verify", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Hostname: args.Hostname, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh attestation verify", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh attestation verify", nil, err)), nil, nil
+		}
+
 		cmd := []string{"attestation", "verify"}
 
 		// Add positional argument: artifact
@@ -97,16 +133,23 @@ func RegisterAttestationVerifyTool(server *mcp.Server, exec *executor.Executor)
 			cmd = append(cmd, "--signer-workflow", args.SignerWorkflow)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_attestation_verify", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh attestation verify failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh attestation verify", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -120,14 +163,47 @@ type AttestationDownloadArgs struct {
 	Repo          string `json:"repo,omitempty" jsonschema:"Repository name in OWNER/REPO format"`
 
 	Artifact string `json:"artifact,omitempty" jsonschema:"File path or OCI URI of artifact (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// attestationDownloadInputSpec holds the argument constraints for gh attestation download
+var attestationDownloadInputSpec = toolkit.InputSpec{
+	Required: []string{"artifact"},
+	Properties: map[string]toolkit.Property{
+		"digest_alg": {Enum: []string{"sha256", "sha512"}},
+	},
 }
 
 // RegisterAttestationDownloadTool registers the gh attestation download tool
-func RegisterAttestationDownloadTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterAttestationDownloadTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_attestation_download",
 		Description: "Download attestations associated with an artifact for offline use",
+		InputSchema: toolkit.InputSchema[AttestationDownloadArgs](attestationDownloadInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Download Attestations",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args AttestationDownloadArgs) (*mcp.CallToolResult, any, error) {
+		if err := attestationDownloadInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh attestation download", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Hostname: args.Hostname, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh attestation download", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh attestation download", nil, err)), nil, nil
+		}
+
 		cmd := []string{"attestation", "download"}
 
 		// Add positional argument: artifact
@@ -159,16 +235,23 @@ func RegisterAttestationDownloadTool(server *mcp.Server, exec *executor.Executor
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_attestation_download", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh attestation download failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh attestation download", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -178,14 +261,39 @@ type AttestationTrustedRootArgs struct {
 	TufRoot    string `json:"tuf_root,omitempty" jsonschema:"Path to the TUF root.json file on disk"`
 	TufUrl     string `json:"tuf_url,omitempty" jsonschema:"URL to the TUF repository mirror"`
 	VerifyOnly bool   `json:"verify_only,omitempty" jsonschema:"Don't output trusted_root.jsonl contents"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// attestationTrustedRootInputSpec holds the argument constraints for gh attestation trusted-root
+var attestationTrustedRootInputSpec = toolkit.InputSpec{}
+
 // RegisterAttestationTrustedRootTool registers the gh attestation trusted-root tool
-func RegisterAttestationTrustedRootTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterAttestationTrustedRootTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_attestation_trusted_root",
 		Description: "Output trusted_root.jsonl contents for offline verification",
+		InputSchema: toolkit.InputSchema[AttestationTrustedRootArgs](attestationTrustedRootInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Show Trusted Root",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args AttestationTrustedRootArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Hostname: args.Hostname, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh attestation trusted-root", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh attestation trusted-root", nil, err)), nil, nil
+		}
+
 		cmd := []string{"attestation", "trusted-root"}
 
 		if args.Hostname != "" {
@@ -204,15 +312,29 @@ func RegisterAttestationTrustedRootTool(server *mcp.Server, exec *executor.Execu
 			cmd = append(cmd, "--verify-only")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_attestation_trusted_root", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh attestation trusted-root failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh attestation trusted-root", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterAttestationTools registers the gh attestation tools
+func RegisterAttestationTools(server *mcp.Server, exec executor.Runner) {
+	RegisterAttestationVerifyTool(server, exec)
+	RegisterAttestationDownloadTool(server, exec)
+	RegisterAttestationTrustedRootTool(server, exec)
+}
@@ -0,0 +1,14 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestAttestationArgv calls every gh attestation tool with representative
+// arguments and compares the commands they run with testdata/attestation.golden
+func TestAttestationArgv(t *testing.T) {
+	checkArgvGolden(t, "attestation", []argvCase{
+		{Tool: "gh_attestation_verify", Args: map[string]any{"artifact": "sample-artifact", "bundle": "sample-bundle", "bundle_from_oci": true, "cert_identity": "sample-cert-identity", "cert_identity_regex": "sample-cert-identity-regex", "cert_oidc_issuer": "sample-cert-oidc-issuer", "custom_trusted_root": "sample-custom-trusted-root", "deny_self_hosted_runners": true, "digest_alg": "sha256", "hostname": "sample-hostname", "owner": "sample-owner", "predicate_type": "sample-predicate-type", "repo": "sample-repo", "signer_repo": "sample-signer-repo", "signer_workflow": "sample-signer-workflow"}},
+		{Tool: "gh_attestation_download", Args: map[string]any{"artifact": "sample-artifact", "digest_alg": "sha256", "hostname": "sample-hostname", "limit": 1, "owner": "sample-owner", "predicate_type": "sample-predicate-type", "repo": "sample-repo"}},
+		{Tool: "gh_attestation_trusted_root", Args: map[string]any{"hostname": "sample-hostname", "tuf_root": "sample-tuf-root", "tuf_url": "sample-tuf-url", "verify_only": true}},
+	})
+}
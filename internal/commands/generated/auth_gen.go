@@ -2,9 +2,11 @@
 package generated
 
 import (
+	"cmp"
 	"context"
-	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -15,15 +17,44 @@ type AuthLoginArgs struct {
 	Scopes      []string `json:"scopes,omitempty" jsonschema:"Additional authentication scopes"`
 	SkipSshKey  bool     `json:"skip_ssh_key,omitempty" jsonschema:"Skip adding SSH key"`
 	Web         bool     `json:"web,omitempty" jsonschema:"Open browser for authentication"`
-	WithToken   bool     `json:"with_token,omitempty" jsonschema:"Read token from standard input"`
+	Token       string   `json:"token,omitempty" jsonschema:"Authentication token, sent to gh on standard input"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// authLoginInputSpec holds the argument constraints for gh auth login
+var authLoginInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"git_protocol": {Enum: []string{"https", "ssh"}},
+	},
 }
 
 // RegisterAuthLoginTool registers the gh auth login tool
-func RegisterAuthLoginTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterAuthLoginTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_auth_login",
 		Description: "Log in to GitHub",
+		InputSchema: toolkit.InputSchema[AuthLoginArgs](authLoginInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Log In",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args AuthLoginArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Hostname: args.Hostname, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh auth login", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh auth login", nil, err)), nil, nil
+		}
+
 		cmd := []string{"auth", "login"}
 
 		if args.Hostname != "" {
@@ -46,20 +77,28 @@ func RegisterAuthLoginTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--web")
 		}
 
-		if args.WithToken {
+		if args.Token != "" {
 			cmd = append(cmd, "--with-token")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_auth_login", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+			Stdin:       args.Token,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh auth login failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh auth login", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -67,14 +106,39 @@ func RegisterAuthLoginTool(server *mcp.Server, exec *executor.Executor) {
 type AuthLogoutArgs struct {
 	Hostname string `json:"hostname,omitempty" jsonschema:"GitHub hostname"`
 	User     string `json:"user,omitempty" jsonschema:"GitHub username"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// authLogoutInputSpec holds the argument constraints for gh auth logout
+var authLogoutInputSpec = toolkit.InputSpec{}
+
 // RegisterAuthLogoutTool registers the gh auth logout tool
-func RegisterAuthLogoutTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterAuthLogoutTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_auth_logout",
 		Description: "Log out of GitHub",
+		InputSchema: toolkit.InputSchema[AuthLogoutArgs](authLogoutInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Log Out",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args AuthLogoutArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Hostname: args.Hostname, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh auth logout", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh auth logout", nil, err)), nil, nil
+		}
+
 		cmd := []string{"auth", "logout"}
 
 		if args.Hostname != "" {
@@ -85,16 +149,23 @@ func RegisterAuthLogoutTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--user", args.User)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_auth_logout", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh auth logout failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh auth logout", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -105,14 +176,39 @@ type AuthRefreshArgs struct {
 	RemoveInsecureStorage bool     `json:"remove_insecure_storage,omitempty" jsonschema:"Remove insecurely stored credential"`
 	ResetScopes           bool     `json:"reset_scopes,omitempty" jsonschema:"Reset scopes to default"`
 	Scopes                []string `json:"scopes,omitempty" jsonschema:"Additional authentication scopes"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// authRefreshInputSpec holds the argument constraints for gh auth refresh
+var authRefreshInputSpec = toolkit.InputSpec{}
+
 // RegisterAuthRefreshTool registers the gh auth refresh tool
-func RegisterAuthRefreshTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterAuthRefreshTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_auth_refresh",
 		Description: "Refresh stored authentication credentials",
+		InputSchema: toolkit.InputSchema[AuthRefreshArgs](authRefreshInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Refresh Credentials",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args AuthRefreshArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Hostname: args.Hostname, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh auth refresh", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh auth refresh", nil, err)), nil, nil
+		}
+
 		cmd := []string{"auth", "refresh"}
 
 		if args.Hostname != "" {
@@ -135,16 +231,23 @@ func RegisterAuthRefreshTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--scopes", v)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_auth_refresh", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh auth refresh failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh auth refresh", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -152,15 +255,39 @@ func RegisterAuthRefreshTool(server *mcp.Server, exec *executor.Executor) {
 type AuthStatusArgs struct {
 	ActiveAccount bool   `json:"active_account,omitempty" jsonschema:"Display the active account"`
 	Hostname      string `json:"hostname,omitempty" jsonschema:"GitHub hostname"`
-	ShowToken     bool   `json:"show_token,omitempty" jsonschema:"Display authentication token"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// authStatusInputSpec holds the argument constraints for gh auth status
+var authStatusInputSpec = toolkit.InputSpec{}
+
 // RegisterAuthStatusTool registers the gh auth status tool
-func RegisterAuthStatusTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterAuthStatusTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_auth_status",
 		Description: "View authentication status",
+		InputSchema: toolkit.InputSchema[AuthStatusArgs](authStatusInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Authentication Status",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args AuthStatusArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Hostname: args.Hostname, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh auth status", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh auth status", nil, err)), nil, nil
+		}
+
 		cmd := []string{"auth", "status"}
 
 		if args.ActiveAccount {
@@ -171,20 +298,23 @@ func RegisterAuthStatusTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--hostname", args.Hostname)
 		}
 
-		if args.ShowToken {
-			cmd = append(cmd, "--show-token")
-		}
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
 
-		result, err := exec.Execute(ctx, cmd...)
+		result, err := exec.Run(ctx, "gh_auth_status", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh auth status failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh auth status", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -192,14 +322,39 @@ func RegisterAuthStatusTool(server *mcp.Server, exec *executor.Executor) {
 type AuthTokenArgs struct {
 	Hostname string `json:"hostname,omitempty" jsonschema:"GitHub hostname"`
 	User     string `json:"user,omitempty" jsonschema:"GitHub username"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// authTokenInputSpec holds the argument constraints for gh auth token
+var authTokenInputSpec = toolkit.InputSpec{}
+
 // RegisterAuthTokenTool registers the gh auth token tool
-func RegisterAuthTokenTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterAuthTokenTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_auth_token",
 		Description: "Print the authentication token",
+		InputSchema: toolkit.InputSchema[AuthTokenArgs](authTokenInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Print Auth Token",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(false),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args AuthTokenArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Hostname: args.Hostname, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh auth token", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh auth token", nil, err)), nil, nil
+		}
+
 		cmd := []string{"auth", "token"}
 
 		if args.Hostname != "" {
@@ -210,32 +365,65 @@ func RegisterAuthTokenTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--user", args.User)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_auth_token", executor.Options{
+			Timeout:         toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:             dir,
+			Env:             defaults.Env(),
+			Credentials:     creds,
+			SensitiveOutput: true,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh auth token failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh auth token", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
-// AuthSetupGitArgs defines parameters for gh auth setup_git
+// AuthSetupGitArgs defines parameters for gh auth setup-git
 type AuthSetupGitArgs struct {
 	Force    bool   `json:"force,omitempty" jsonschema:"Force setup even if already configured"`
 	Hostname string `json:"hostname,omitempty" jsonschema:"GitHub hostname"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
-// RegisterAuthSetupGitTool registers the gh auth setup_git tool
-func RegisterAuthSetupGitTool(server *mcp.Server, exec *executor.Executor) {
+// authSetupGitInputSpec holds the argument constraints for gh auth setup-git
+var authSetupGitInputSpec = toolkit.InputSpec{}
+
+// RegisterAuthSetupGitTool registers the gh auth setup-git tool
+func RegisterAuthSetupGitTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_auth_setup_git",
 		Description: "Configure git to use GitHub CLI as credential helper",
+		InputSchema: toolkit.InputSchema[AuthSetupGitArgs](authSetupGitInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Set Up Git Credentials",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(false),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args AuthSetupGitArgs) (*mcp.CallToolResult, any, error) {
-		cmd := []string{"auth", "setup_git"}
+
+		defaults := session.For(req).Without(session.Defaults{Hostname: args.Hostname, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh auth setup-git", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh auth setup-git", nil, err)), nil, nil
+		}
+
+		cmd := []string{"auth", "setup-git"}
 
 		if args.Force {
 			cmd = append(cmd, "--force")
@@ -245,15 +433,32 @@ func RegisterAuthSetupGitTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--hostname", args.Hostname)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_auth_setup_git", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh auth setup_git failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh auth setup-git", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterAuthTools registers the gh auth tools
+func RegisterAuthTools(server *mcp.Server, exec executor.Runner) {
+	RegisterAuthLoginTool(server, exec)
+	RegisterAuthLogoutTool(server, exec)
+	RegisterAuthRefreshTool(server, exec)
+	RegisterAuthStatusTool(server, exec)
+	RegisterAuthTokenTool(server, exec)
+	RegisterAuthSetupGitTool(server, exec)
+}
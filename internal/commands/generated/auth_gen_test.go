@@ -0,0 +1,17 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestAuthArgv calls every gh auth tool with representative
+// arguments and compares the commands they run with testdata/auth.golden
+func TestAuthArgv(t *testing.T) {
+	checkArgvGolden(t, "auth", []argvCase{
+		{Tool: "gh_auth_login", Args: map[string]any{"hostname": "sample-hostname", "git_protocol": "https", "scopes": []any{"sample-scopes"}, "skip_ssh_key": true, "web": true, "token": "sample-token"}},
+		{Tool: "gh_auth_logout", Args: map[string]any{"hostname": "sample-hostname", "user": "sample-user"}},
+		{Tool: "gh_auth_refresh", Args: map[string]any{"hostname": "sample-hostname", "insecure_storage": true, "remove_insecure_storage": true, "reset_scopes": true, "scopes": []any{"sample-scopes"}}},
+		{Tool: "gh_auth_status", Args: map[string]any{"active_account": true, "hostname": "sample-hostname"}},
+		{Tool: "gh_auth_token", Args: map[string]any{"hostname": "sample-hostname", "user": "sample-user"}},
+		{Tool: "gh_auth_setup_git", Args: map[string]any{"force": true, "hostname": "sample-hostname"}},
+	})
+}
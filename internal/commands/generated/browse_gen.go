@@ -2,13 +2,15 @@
 package generated
 
 import (
+	"cmp"
 	"context"
-	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
-// BrowseBrowseArgs defines parameters for gh browse browse
+// BrowseBrowseArgs defines parameters for gh browse
 type BrowseBrowseArgs struct {
 	Actions   bool   `json:"actions,omitempty" jsonschema:"Open repository actions"`
 	Branch    string `json:"branch,omitempty" jsonschema:"Select another branch by passing in the branch name"`
@@ -21,15 +23,40 @@ type BrowseBrowseArgs struct {
 	Repo      string `json:"repo,omitempty" jsonschema:"Select repository in OWNER/REPO format"`
 
 	Target string `json:"target,omitempty" jsonschema:"Target to browse (number, path, or commit SHA) (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
-// RegisterBrowseBrowseTool registers the gh browse browse tool
-func RegisterBrowseBrowseTool(server *mcp.Server, exec *executor.Executor) {
+// browseBrowseInputSpec holds the argument constraints for gh browse
+var browseBrowseInputSpec = toolkit.InputSpec{}
+
+// RegisterBrowseBrowseTool registers the gh browse tool
+func RegisterBrowseBrowseTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_browse_browse",
 		Description: "Open repository, issue, pull request, or file in the browser",
+		InputSchema: toolkit.InputSchema[BrowseBrowseArgs](browseBrowseInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Open in Browser",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args BrowseBrowseArgs) (*mcp.CallToolResult, any, error) {
-		cmd := []string{"browse", "browse"}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh browse", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh browse", nil, err)), nil, nil
+		}
+
+		cmd := []string{"browse"}
 
 		// Add positional argument: target
 		if args.Target != "" {
@@ -72,15 +99,27 @@ func RegisterBrowseBrowseTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_browse_browse", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh browse browse failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh browse", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterBrowseTools registers the gh browse tools
+func RegisterBrowseTools(server *mcp.Server, exec executor.Runner) {
+	RegisterBrowseBrowseTool(server, exec)
+}
@@ -0,0 +1,12 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestBrowseArgv calls every gh browse tool with representative
+// arguments and compares the commands they run with testdata/browse.golden
+func TestBrowseArgv(t *testing.T) {
+	checkArgvGolden(t, "browse", []argvCase{
+		{Tool: "gh_browse_browse", Args: map[string]any{"target": "sample-target", "actions": true, "branch": "sample-branch", "commit": "sample-commit", "no_browser": true, "projects": true, "releases": true, "settings": true, "wiki": true, "repo": "sample-repo"}},
+	})
+}
@@ -2,9 +2,12 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -15,18 +18,72 @@ type CacheListArgs struct {
 	Order    string   `json:"order,omitempty" jsonschema:"Order of caches returned"`
 	Ref      string   `json:"ref,omitempty" jsonschema:"Filter by ref (formatted as refs/heads/<branch> or refs/pull/<number>/merge)"`
 	Sort     string   `json:"sort,omitempty" jsonschema:"Sort fetched caches"`
-	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: id,key,ref,sizeInBytes,lastAccessedAt)"`
 	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Repo     string   `json:"repo,omitempty" jsonschema:"Select repository in OWNER/REPO format"`
+	Format   string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// cacheListInputSpec holds the argument constraints for gh cache list
+var cacheListInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"order":  {Enum: []string{"asc", "desc"}, Default: "desc"},
+		"sort":   {Enum: []string{"created_at", "last_accessed_at", "size_in_bytes"}, Default: "last_accessed_at"},
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
+}
+
+// cacheListOutputSpec describes the --json output of gh cache list
+var cacheListOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "createdAt", Type: "string", Description: "When the cache was created"},
+		{Name: "id", Type: "integer", Description: "Cache ID"},
+		{Name: "key", Type: "string", Description: "Cache key"},
+		{Name: "lastAccessedAt", Type: "string", Description: "When the cache was last accessed"},
+		{Name: "ref", Type: "string", Description: "Git ref the cache belongs to"},
+		{Name: "sizeInBytes", Type: "integer", Description: "Cache size in bytes"},
+		{Name: "version", Type: "string", Description: "Cache version"},
+	},
 }
 
+// cacheListDefaultJSONFields are requested when no json fields are given
+var cacheListDefaultJSONFields = []string{"id", "key", "ref", "sizeInBytes", "lastAccessedAt"}
+
 // RegisterCacheListTool registers the gh cache list tool
-func RegisterCacheListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterCacheListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_cache_list",
-		Description: "List GitHub Actions caches",
+		Name:         "gh_cache_list",
+		Description:  "List GitHub Actions caches",
+		InputSchema:  toolkit.InputSchema[CacheListArgs](cacheListInputSpec),
+		OutputSchema: toolkit.OutputSchema(cacheListOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Actions Caches",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args CacheListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh cache list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh cache list", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" {
+			args.Json = cacheListDefaultJSONFields
+		}
+
 		cmd := []string{"cache", "list"}
 
 		if args.Key != "" {
@@ -65,16 +122,23 @@ func RegisterCacheListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_cache_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh cache list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh cache list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -86,14 +150,40 @@ type CacheDeleteArgs struct {
 	Repo              string `json:"repo,omitempty" jsonschema:"Select repository in OWNER/REPO format"`
 
 	CacheId string `json:"cache_id,omitempty" jsonschema:"Cache ID or cache key (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
 }
 
+// cacheDeleteInputSpec holds the argument constraints for gh cache delete
+var cacheDeleteInputSpec = toolkit.InputSpec{}
+
 // RegisterCacheDeleteTool registers the gh cache delete tool
-func RegisterCacheDeleteTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterCacheDeleteTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_cache_delete",
 		Description: "Delete GitHub Actions caches",
+		InputSchema: toolkit.InputSchema[CacheDeleteArgs](cacheDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete Actions Caches",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args CacheDeleteArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh cache delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh cache delete", nil, err)), nil, nil
+		}
+
 		cmd := []string{"cache", "delete"}
 
 		// Add positional argument: cache_id
@@ -117,15 +207,34 @@ func RegisterCacheDeleteTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh cache delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_cache_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh cache delete failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh cache delete", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterCacheTools registers the gh cache tools
+func RegisterCacheTools(server *mcp.Server, exec executor.Runner) {
+	RegisterCacheListTool(server, exec)
+	RegisterCacheDeleteTool(server, exec)
+}
@@ -0,0 +1,13 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestCacheArgv calls every gh cache tool with representative
+// arguments and compares the commands they run with testdata/cache.golden
+func TestCacheArgv(t *testing.T) {
+	checkArgvGolden(t, "cache", []argvCase{
+		{Tool: "gh_cache_list", Args: map[string]any{"key": "sample-key", "limit": 1, "order": "asc", "ref": "sample-ref", "sort": "created_at", "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "repo": "sample-repo", "format": "json"}},
+		{Tool: "gh_cache_delete", Args: map[string]any{"cache_id": "sample-cache-id", "all": true, "ref": "sample-ref", "succeed_on_no_caches": true, "repo": "sample-repo"}},
+	})
+}
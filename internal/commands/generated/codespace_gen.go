@@ -2,30 +2,88 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // CodespaceListArgs defines parameters for gh codespace list
 type CodespaceListArgs struct {
 	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
-	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: name,displayName,repository,state,gitStatus,lastUsedAt)"`
 	Limit    int      `json:"limit,omitempty" jsonschema:"Maximum number of codespaces to list"`
 	Org      string   `json:"org,omitempty" jsonschema:"The login handle of the organization to list codespaces for (admin-only)"`
 	Repo     string   `json:"repo,omitempty" jsonschema:"Repository name with owner (user/repo)"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	User     string   `json:"user,omitempty" jsonschema:"The username to list codespaces for (used with --org)"`
 	Web      bool     `json:"web,omitempty" jsonschema:"List codespaces in the web browser"`
+	Format   string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// codespaceListInputSpec holds the argument constraints for gh codespace list
+var codespaceListInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
 }
 
+// codespaceListOutputSpec describes the --json output of gh codespace list
+var codespaceListOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "createdAt", Type: "string", Description: "When the codespace was created"},
+		{Name: "displayName", Type: "string", Description: "Display name"},
+		{Name: "gitStatus", Type: "object", Description: "Git status of the codespace"},
+		{Name: "lastUsedAt", Type: "string", Description: "When the codespace was last used"},
+		{Name: "machineName", Type: "string", Description: "Machine type"},
+		{Name: "name", Type: "string", Description: "Codespace name"},
+		{Name: "owner", Type: "string", Description: "Owner login"},
+		{Name: "repository", Type: "string", Description: "Repository in OWNER/REPO format"},
+		{Name: "state", Type: "string", Description: "Codespace state"},
+		{Name: "vscsTarget", Type: "string", Description: "Codespaces service target"},
+	},
+}
+
+// codespaceListDefaultJSONFields are requested when no json fields are given
+var codespaceListDefaultJSONFields = []string{"name", "displayName", "repository", "state", "gitStatus", "lastUsedAt"}
+
 // RegisterCodespaceListTool registers the gh codespace list tool
-func RegisterCodespaceListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterCodespaceListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_codespace_list",
-		Description: "List codespaces of the authenticated user",
+		Name:         "gh_codespace_list",
+		Description:  "List codespaces of the authenticated user",
+		InputSchema:  toolkit.InputSchema[CodespaceListArgs](codespaceListInputSpec),
+		OutputSchema: toolkit.OutputSchema(codespaceListOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Codespaces",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args CodespaceListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh codespace list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace list", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" && !args.Web {
+			args.Json = codespaceListDefaultJSONFields
+		}
+
 		cmd := []string{"codespace", "list"}
 
 		if args.Jq != "" {
@@ -60,16 +118,23 @@ func RegisterCodespaceListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--web")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_codespace_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh codespace list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -86,14 +151,39 @@ type CodespaceCreateArgs struct {
 	RetentionPeriod    string `json:"retention_period,omitempty" jsonschema:"Allowed time after shutting down before auto-deletion (e.g. '1h', '72h')"`
 	Status             bool   `json:"status,omitempty" jsonschema:"Show status of post-create command and dotfiles"`
 	Web                bool   `json:"web,omitempty" jsonschema:"Create codespace from browser"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// codespaceCreateInputSpec holds the argument constraints for gh codespace create
+var codespaceCreateInputSpec = toolkit.InputSpec{}
+
 // RegisterCodespaceCreateTool registers the gh codespace create tool
-func RegisterCodespaceCreateTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterCodespaceCreateTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_codespace_create",
 		Description: "Create a codespace",
+		InputSchema: toolkit.InputSchema[CodespaceCreateArgs](codespaceCreateInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Create Codespace",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args CodespaceCreateArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh codespace create", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace create", nil, err)), nil, nil
+		}
+
 		cmd := []string{"codespace", "create"}
 
 		if args.Branch != "" {
@@ -140,16 +230,24 @@ func RegisterCodespaceCreateTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--web")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_codespace_create", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 900),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+			OnLine:      toolkit.Progress(ctx, req),
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh codespace create failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace create", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -163,14 +261,40 @@ type CodespaceDeleteArgs struct {
 	Repo      string `json:"repo,omitempty" jsonschema:"Filter codespace selection by repository name (user/repo)"`
 	RepoOwner string `json:"repo_owner,omitempty" jsonschema:"Filter codespace selection by repository owner"`
 	User      string `json:"user,omitempty" jsonschema:"The username to delete codespaces for (used with --org)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
 }
 
+// codespaceDeleteInputSpec holds the argument constraints for gh codespace delete
+var codespaceDeleteInputSpec = toolkit.InputSpec{}
+
 // RegisterCodespaceDeleteTool registers the gh codespace delete tool
-func RegisterCodespaceDeleteTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterCodespaceDeleteTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_codespace_delete",
 		Description: "Delete codespaces based on selection criteria",
+		InputSchema: toolkit.InputSchema[CodespaceDeleteArgs](codespaceDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete Codespaces",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args CodespaceDeleteArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh codespace delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace delete", nil, err)), nil, nil
+		}
+
 		cmd := []string{"codespace", "delete"}
 
 		if args.All {
@@ -205,16 +329,29 @@ func RegisterCodespaceDeleteTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--user", args.User)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh codespace delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_codespace_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh codespace delete failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace delete", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -226,14 +363,66 @@ type CodespaceViewArgs struct {
 	Repo      string   `json:"repo,omitempty" jsonschema:"Filter codespace selection by repository name (user/repo)"`
 	RepoOwner string   `json:"repo_owner,omitempty" jsonschema:"Filter codespace selection by repository owner"`
 	Template  string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// codespaceViewInputSpec holds the argument constraints for gh codespace view
+var codespaceViewInputSpec = toolkit.InputSpec{}
+
+// codespaceViewOutputSpec describes the --json output of gh codespace view
+var codespaceViewOutputSpec = toolkit.OutputSpec{
+	Fields: []toolkit.OutputField{
+		{Name: "billableOwner", Type: "object", Description: "Account billed for the codespace"},
+		{Name: "createdAt", Type: "string", Description: "When the codespace was created"},
+		{Name: "devcontainerPath", Type: "string", Description: "Dev container configuration path"},
+		{Name: "displayName", Type: "string", Description: "Display name"},
+		{Name: "environmentId", Type: "string", Description: "Environment ID"},
+		{Name: "gitStatus", Type: "object", Description: "Git status of the codespace"},
+		{Name: "idleTimeoutMinutes", Type: "integer", Description: "Idle timeout in minutes"},
+		{Name: "lastUsedAt", Type: "string", Description: "When the codespace was last used"},
+		{Name: "location", Type: "string", Description: "Region"},
+		{Name: "machineDisplayName", Type: "string", Description: "Machine type display name"},
+		{Name: "machineName", Type: "string", Description: "Machine type"},
+		{Name: "name", Type: "string", Description: "Codespace name"},
+		{Name: "owner", Type: "string", Description: "Owner login"},
+		{Name: "prebuild", Type: "boolean", Description: "Whether the codespace was created from a prebuild"},
+		{Name: "recentFolders", Type: "array", Description: "Recently opened folders"},
+		{Name: "repository", Type: "string", Description: "Repository in OWNER/REPO format"},
+		{Name: "retentionExpiresAt", Type: "string", Description: "When the codespace will be deleted"},
+		{Name: "retentionPeriodMinutes", Type: "integer", Description: "Retention period in minutes"},
+		{Name: "state", Type: "string", Description: "Codespace state"},
+		{Name: "vscsTarget", Type: "string", Description: "Codespaces service target"},
+	},
 }
 
 // RegisterCodespaceViewTool registers the gh codespace view tool
-func RegisterCodespaceViewTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterCodespaceViewTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_codespace_view",
-		Description: "View details about a codespace",
+		Name:         "gh_codespace_view",
+		Description:  "View details about a codespace",
+		InputSchema:  toolkit.InputSchema[CodespaceViewArgs](codespaceViewInputSpec),
+		OutputSchema: toolkit.OutputSchema(codespaceViewOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "View Codespace",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args CodespaceViewArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh codespace view", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace view", nil, err)), nil, nil
+		}
+
 		cmd := []string{"codespace", "view"}
 
 		if args.Codespace != "" {
@@ -260,16 +449,23 @@ func RegisterCodespaceViewTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--template", args.Template)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_codespace_view", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh codespace view failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace view", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, false)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -280,14 +476,39 @@ type CodespaceStopArgs struct {
 	Repo      string `json:"repo,omitempty" jsonschema:"Filter codespace selection by repository name (user/repo)"`
 	RepoOwner string `json:"repo_owner,omitempty" jsonschema:"Filter codespace selection by repository owner"`
 	User      string `json:"user,omitempty" jsonschema:"The username to stop codespace for (used with --org)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// codespaceStopInputSpec holds the argument constraints for gh codespace stop
+var codespaceStopInputSpec = toolkit.InputSpec{}
+
 // RegisterCodespaceStopTool registers the gh codespace stop tool
-func RegisterCodespaceStopTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterCodespaceStopTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_codespace_stop",
 		Description: "Stop a running codespace",
+		InputSchema: toolkit.InputSchema[CodespaceStopArgs](codespaceStopInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Stop Codespace",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args CodespaceStopArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh codespace stop", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace stop", nil, err)), nil, nil
+		}
+
 		cmd := []string{"codespace", "stop"}
 
 		if args.Codespace != "" {
@@ -310,16 +531,23 @@ func RegisterCodespaceStopTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--user", args.User)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_codespace_stop", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh codespace stop failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace stop", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -333,14 +561,39 @@ type CodespaceSshArgs struct {
 	Repo       string `json:"repo,omitempty" jsonschema:"Filter codespace selection by repository name (user/repo)"`
 	RepoOwner  string `json:"repo_owner,omitempty" jsonschema:"Filter codespace selection by repository owner"`
 	ServerPort int    `json:"server_port,omitempty" jsonschema:"SSH server port number (0 => pick unused)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// codespaceSshInputSpec holds the argument constraints for gh codespace ssh
+var codespaceSshInputSpec = toolkit.InputSpec{}
+
 // RegisterCodespaceSshTool registers the gh codespace ssh tool
-func RegisterCodespaceSshTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterCodespaceSshTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_codespace_ssh",
 		Description: "SSH into a codespace",
+		InputSchema: toolkit.InputSchema[CodespaceSshArgs](codespaceSshInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "SSH into Codespace",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args CodespaceSshArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh codespace ssh", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace ssh", nil, err)), nil, nil
+		}
+
 		cmd := []string{"codespace", "ssh"}
 
 		if args.Codespace != "" {
@@ -375,16 +628,23 @@ func RegisterCodespaceSshTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--server-port", fmt.Sprintf("%d", args.ServerPort))
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_codespace_ssh", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh codespace ssh failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace ssh", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -394,14 +654,39 @@ type CodespaceLogsArgs struct {
 	Follow    bool   `json:"follow,omitempty" jsonschema:"Tail and follow the logs"`
 	Repo      string `json:"repo,omitempty" jsonschema:"Filter codespace selection by repository name (user/repo)"`
 	RepoOwner string `json:"repo_owner,omitempty" jsonschema:"Filter codespace selection by repository owner"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// codespaceLogsInputSpec holds the argument constraints for gh codespace logs
+var codespaceLogsInputSpec = toolkit.InputSpec{}
+
 // RegisterCodespaceLogsTool registers the gh codespace logs tool
-func RegisterCodespaceLogsTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterCodespaceLogsTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_codespace_logs",
 		Description: "Access codespace logs",
+		InputSchema: toolkit.InputSchema[CodespaceLogsArgs](codespaceLogsInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Codespace Logs",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args CodespaceLogsArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh codespace logs", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace logs", nil, err)), nil, nil
+		}
+
 		cmd := []string{"codespace", "logs"}
 
 		if args.Codespace != "" {
@@ -420,35 +705,79 @@ func RegisterCodespaceLogsTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo-owner", args.RepoOwner)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_codespace_logs", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh codespace logs failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace logs", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
-// CodespacePortsArgs defines parameters for gh codespace ports
-type CodespacePortsArgs struct {
+// CodespacePortsListArgs defines parameters for gh codespace ports
+type CodespacePortsListArgs struct {
 	Codespace string   `json:"codespace,omitempty" jsonschema:"Name of the codespace"`
 	Jq        string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Json      []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
 	Repo      string   `json:"repo,omitempty" jsonschema:"Filter codespace selection by repository name (user/repo)"`
 	RepoOwner string   `json:"repo_owner,omitempty" jsonschema:"Filter codespace selection by repository owner"`
 	Template  string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// codespacePortsListInputSpec holds the argument constraints for gh codespace ports
+var codespacePortsListInputSpec = toolkit.InputSpec{}
+
+// codespacePortsListOutputSpec describes the --json output of gh codespace ports
+var codespacePortsListOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "browseUrl", Type: "string", Description: "URL of the forwarded port"},
+		{Name: "label", Type: "string", Description: "Port label"},
+		{Name: "sourcePort", Type: "integer", Description: "Port number in the codespace"},
+		{Name: "visibility", Type: "string", Description: "Port visibility"},
+	},
 }
 
-// RegisterCodespacePortsTool registers the gh codespace ports tool
-func RegisterCodespacePortsTool(server *mcp.Server, exec *executor.Executor) {
+// RegisterCodespacePortsListTool registers the gh codespace ports tool
+func RegisterCodespacePortsListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_codespace_ports",
-		Description: "List ports in a codespace",
-	}, func(ctx context.Context, req *mcp.CallToolRequest, args CodespacePortsArgs) (*mcp.CallToolResult, any, error) {
+		Name:         "gh_codespace_ports_list",
+		Description:  "List ports in a codespace",
+		InputSchema:  toolkit.InputSchema[CodespacePortsListArgs](codespacePortsListInputSpec),
+		OutputSchema: toolkit.OutputSchema(codespacePortsListOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Codespace Ports",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args CodespacePortsListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh codespace ports", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace ports", nil, err)), nil, nil
+		}
+
 		cmd := []string{"codespace", "ports"}
 
 		if args.Codespace != "" {
@@ -475,16 +804,23 @@ func RegisterCodespacePortsTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--template", args.Template)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_codespace_ports_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh codespace ports failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace ports", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -495,14 +831,39 @@ type CodespaceEditArgs struct {
 	Machine     string `json:"machine,omitempty" jsonschema:"Set hardware specifications for the VM"`
 	Repo        string `json:"repo,omitempty" jsonschema:"Filter codespace selection by repository name (user/repo)"`
 	RepoOwner   string `json:"repo_owner,omitempty" jsonschema:"Filter codespace selection by repository owner"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// codespaceEditInputSpec holds the argument constraints for gh codespace edit
+var codespaceEditInputSpec = toolkit.InputSpec{}
+
 // RegisterCodespaceEditTool registers the gh codespace edit tool
-func RegisterCodespaceEditTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterCodespaceEditTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_codespace_edit",
 		Description: "Edit a codespace",
+		InputSchema: toolkit.InputSchema[CodespaceEditArgs](codespaceEditInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Edit Codespace",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args CodespaceEditArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh codespace edit", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace edit", nil, err)), nil, nil
+		}
+
 		cmd := []string{"codespace", "edit"}
 
 		if args.Codespace != "" {
@@ -525,16 +886,23 @@ func RegisterCodespaceEditTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo-owner", args.RepoOwner)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_codespace_edit", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh codespace edit failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace edit", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -544,14 +912,39 @@ type CodespaceRebuildArgs struct {
 	Full      bool   `json:"full,omitempty" jsonschema:"Perform a full rebuild"`
 	Repo      string `json:"repo,omitempty" jsonschema:"Filter codespace selection by repository name (user/repo)"`
 	RepoOwner string `json:"repo_owner,omitempty" jsonschema:"Filter codespace selection by repository owner"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// codespaceRebuildInputSpec holds the argument constraints for gh codespace rebuild
+var codespaceRebuildInputSpec = toolkit.InputSpec{}
+
 // RegisterCodespaceRebuildTool registers the gh codespace rebuild tool
-func RegisterCodespaceRebuildTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterCodespaceRebuildTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_codespace_rebuild",
 		Description: "Rebuild a codespace",
+		InputSchema: toolkit.InputSchema[CodespaceRebuildArgs](codespaceRebuildInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Rebuild Codespace",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args CodespaceRebuildArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh codespace rebuild", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace rebuild", nil, err)), nil, nil
+		}
+
 		cmd := []string{"codespace", "rebuild"}
 
 		if args.Codespace != "" {
@@ -570,16 +963,23 @@ func RegisterCodespaceRebuildTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo-owner", args.RepoOwner)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_codespace_rebuild", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh codespace rebuild failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace rebuild", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -590,14 +990,39 @@ type CodespaceCodeArgs struct {
 	Repo      string `json:"repo,omitempty" jsonschema:"Filter codespace selection by repository name (user/repo)"`
 	RepoOwner string `json:"repo_owner,omitempty" jsonschema:"Filter codespace selection by repository owner"`
 	Web       bool   `json:"web,omitempty" jsonschema:"Use the web version of Visual Studio Code"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// codespaceCodeInputSpec holds the argument constraints for gh codespace code
+var codespaceCodeInputSpec = toolkit.InputSpec{}
+
 // RegisterCodespaceCodeTool registers the gh codespace code tool
-func RegisterCodespaceCodeTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterCodespaceCodeTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_codespace_code",
 		Description: "Open a codespace in Visual Studio Code",
+		InputSchema: toolkit.InputSchema[CodespaceCodeArgs](codespaceCodeInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Open Codespace in VS Code",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args CodespaceCodeArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh codespace code", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace code", nil, err)), nil, nil
+		}
+
 		cmd := []string{"codespace", "code"}
 
 		if args.Codespace != "" {
@@ -620,16 +1045,23 @@ func RegisterCodespaceCodeTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--web")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_codespace_code", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh codespace code failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace code", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -638,14 +1070,39 @@ type CodespaceJupyterArgs struct {
 	Codespace string `json:"codespace,omitempty" jsonschema:"Name of the codespace"`
 	Repo      string `json:"repo,omitempty" jsonschema:"Filter codespace selection by repository name (user/repo)"`
 	RepoOwner string `json:"repo_owner,omitempty" jsonschema:"Filter codespace selection by repository owner"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// codespaceJupyterInputSpec holds the argument constraints for gh codespace jupyter
+var codespaceJupyterInputSpec = toolkit.InputSpec{}
+
 // RegisterCodespaceJupyterTool registers the gh codespace jupyter tool
-func RegisterCodespaceJupyterTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterCodespaceJupyterTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_codespace_jupyter",
 		Description: "Open a codespace in JupyterLab",
+		InputSchema: toolkit.InputSchema[CodespaceJupyterArgs](codespaceJupyterInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Open Codespace in JupyterLab",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args CodespaceJupyterArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh codespace jupyter", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace jupyter", nil, err)), nil, nil
+		}
+
 		cmd := []string{"codespace", "jupyter"}
 
 		if args.Codespace != "" {
@@ -660,16 +1117,23 @@ func RegisterCodespaceJupyterTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo-owner", args.RepoOwner)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_codespace_jupyter", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh codespace jupyter failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace jupyter", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -683,14 +1147,44 @@ type CodespaceCpArgs struct {
 	RepoOwner string `json:"repo_owner,omitempty" jsonschema:"Filter codespace selection by repository owner"`
 
 	Sources []string `json:"sources,omitempty" jsonschema:"Source paths (positional arguments)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// codespaceCpInputSpec holds the argument constraints for gh codespace cp
+var codespaceCpInputSpec = toolkit.InputSpec{
+	Required: []string{"sources"},
 }
 
 // RegisterCodespaceCpTool registers the gh codespace cp tool
-func RegisterCodespaceCpTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterCodespaceCpTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_codespace_cp",
 		Description: "Copy files between local and remote file systems",
+		InputSchema: toolkit.InputSchema[CodespaceCpArgs](codespaceCpInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Copy Codespace Files",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args CodespaceCpArgs) (*mcp.CallToolResult, any, error) {
+		if err := codespaceCpInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh codespace cp", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh codespace cp", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace cp", nil, err)), nil, nil
+		}
+
 		cmd := []string{"codespace", "cp"}
 
 		// Add positional argument: sources
@@ -720,15 +1214,122 @@ func RegisterCodespaceCpTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo-owner", args.RepoOwner)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_codespace_cp", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace cp", result, err)), nil, nil
+		}
+
+		return defaults.Annotate(&mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: result.Stdout},
+			},
+		}), nil, nil
+	})
+}
+
+// CodespacePortsVisibilityArgs defines parameters for gh codespace ports visibility
+type CodespacePortsVisibilityArgs struct {
+	Codespace string `json:"codespace,omitempty" jsonschema:"Name of the codespace"`
+	Repo      string `json:"repo,omitempty" jsonschema:"Filter codespace selection by repository name (user/repo)"`
+	RepoOwner string `json:"repo_owner,omitempty" jsonschema:"Filter codespace selection by repository owner"`
+
+	PortVisibilities []string `json:"port_visibilities,omitempty" jsonschema:"Port settings in port:public, port:private or port:org format (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// codespacePortsVisibilityInputSpec holds the argument constraints for gh codespace ports visibility
+var codespacePortsVisibilityInputSpec = toolkit.InputSpec{
+	Required: []string{"port_visibilities"},
+}
+
+// RegisterCodespacePortsVisibilityTool registers the gh codespace ports visibility tool
+func RegisterCodespacePortsVisibilityTool(server *mcp.Server, exec executor.Runner) {
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "gh_codespace_ports_visibility",
+		Description: "Change the visibility of forwarded ports",
+		InputSchema: toolkit.InputSchema[CodespacePortsVisibilityArgs](codespacePortsVisibilityInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Set Codespace Port Visibility",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args CodespacePortsVisibilityArgs) (*mcp.CallToolResult, any, error) {
+		if err := codespacePortsVisibilityInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh codespace ports visibility", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh codespace ports visibility", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace ports visibility", nil, err)), nil, nil
+		}
+
+		cmd := []string{"codespace", "ports", "visibility"}
+
+		// Add positional argument: port_visibilities
+		cmd = append(cmd, args.PortVisibilities...)
+
+		if args.Codespace != "" {
+			cmd = append(cmd, "--codespace", args.Codespace)
+		}
+
+		if args.Repo != "" {
+			cmd = append(cmd, "--repo", args.Repo)
+		}
+
+		if args.RepoOwner != "" {
+			cmd = append(cmd, "--repo-owner", args.RepoOwner)
+		}
+
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_codespace_ports_visibility", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh codespace cp failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh codespace ports visibility", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterCodespaceTools registers the gh codespace tools
+func RegisterCodespaceTools(server *mcp.Server, exec executor.Runner) {
+	RegisterCodespaceListTool(server, exec)
+	RegisterCodespaceCreateTool(server, exec)
+	RegisterCodespaceDeleteTool(server, exec)
+	RegisterCodespaceViewTool(server, exec)
+	RegisterCodespaceStopTool(server, exec)
+	RegisterCodespaceSshTool(server, exec)
+	RegisterCodespaceLogsTool(server, exec)
+	RegisterCodespacePortsListTool(server, exec)
+	RegisterCodespaceEditTool(server, exec)
+	RegisterCodespaceRebuildTool(server, exec)
+	RegisterCodespaceCodeTool(server, exec)
+	RegisterCodespaceJupyterTool(server, exec)
+	RegisterCodespaceCpTool(server, exec)
+	RegisterCodespacePortsVisibilityTool(server, exec)
+}
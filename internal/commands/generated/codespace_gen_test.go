@@ -0,0 +1,25 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestCodespaceArgv calls every gh codespace tool with representative
+// arguments and compares the commands they run with testdata/codespace.golden
+func TestCodespaceArgv(t *testing.T) {
+	checkArgvGolden(t, "codespace", []argvCase{
+		{Tool: "gh_codespace_list", Args: map[string]any{"jq": "sample-jq", "json": []any{"sample-json"}, "limit": 1, "org": "sample-org", "repo": "sample-repo", "template": "sample-template", "user": "sample-user", "web": true, "format": "json"}},
+		{Tool: "gh_codespace_create", Args: map[string]any{"branch": "sample-branch", "default_permissions": true, "devcontainer_path": "sample-devcontainer-path", "display_name": "sample-display-name", "idle_timeout": "sample-idle-timeout", "location": "sample-location", "machine": "sample-machine", "repo": "sample-repo", "retention_period": "sample-retention-period", "status": true, "web": true}},
+		{Tool: "gh_codespace_delete", Args: map[string]any{"all": true, "codespace": "sample-codespace", "days": 1, "force": true, "org": "sample-org", "repo": "sample-repo", "repo_owner": "sample-repo-owner", "user": "sample-user"}},
+		{Tool: "gh_codespace_view", Args: map[string]any{"codespace": "sample-codespace", "jq": "sample-jq", "json": []any{"sample-json"}, "repo": "sample-repo", "repo_owner": "sample-repo-owner", "template": "sample-template"}},
+		{Tool: "gh_codespace_stop", Args: map[string]any{"codespace": "sample-codespace", "org": "sample-org", "repo": "sample-repo", "repo_owner": "sample-repo-owner", "user": "sample-user"}},
+		{Tool: "gh_codespace_ssh", Args: map[string]any{"codespace": "sample-codespace", "config": true, "debug": true, "debug_file": "sample-debug-file", "profile": "sample-profile", "repo": "sample-repo", "repo_owner": "sample-repo-owner", "server_port": 1}},
+		{Tool: "gh_codespace_logs", Args: map[string]any{"codespace": "sample-codespace", "follow": true, "repo": "sample-repo", "repo_owner": "sample-repo-owner"}},
+		{Tool: "gh_codespace_ports_list", Args: map[string]any{"codespace": "sample-codespace", "jq": "sample-jq", "json": []any{"sample-json"}, "repo": "sample-repo", "repo_owner": "sample-repo-owner", "template": "sample-template"}},
+		{Tool: "gh_codespace_edit", Args: map[string]any{"codespace": "sample-codespace", "display_name": "sample-display-name", "machine": "sample-machine", "repo": "sample-repo", "repo_owner": "sample-repo-owner"}},
+		{Tool: "gh_codespace_rebuild", Args: map[string]any{"codespace": "sample-codespace", "full": true, "repo": "sample-repo", "repo_owner": "sample-repo-owner"}},
+		{Tool: "gh_codespace_code", Args: map[string]any{"codespace": "sample-codespace", "insiders": true, "repo": "sample-repo", "repo_owner": "sample-repo-owner", "web": true}},
+		{Tool: "gh_codespace_jupyter", Args: map[string]any{"codespace": "sample-codespace", "repo": "sample-repo", "repo_owner": "sample-repo-owner"}},
+		{Tool: "gh_codespace_cp", Args: map[string]any{"sources": []any{"sample-sources"}, "codespace": "sample-codespace", "expand": true, "profile": "sample-profile", "recursive": true, "repo": "sample-repo", "repo_owner": "sample-repo-owner"}},
+		{Tool: "gh_codespace_ports_visibility", Args: map[string]any{"port_visibilities": []any{"sample-port-visibilities"}, "codespace": "sample-codespace", "repo": "sample-repo", "repo_owner": "sample-repo-owner"}},
+	})
+}
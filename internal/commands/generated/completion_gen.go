@@ -2,38 +2,85 @@
 package generated
 
 import (
+	"cmp"
 	"context"
-	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
-// CompletionCompletionArgs defines parameters for gh completion completion
+// CompletionCompletionArgs defines parameters for gh completion
 type CompletionCompletionArgs struct {
 	Shell string `json:"shell,omitempty" jsonschema:"Shell type"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// completionCompletionInputSpec holds the argument constraints for gh completion
+var completionCompletionInputSpec = toolkit.InputSpec{
+	Required: []string{"shell"},
+	Properties: map[string]toolkit.Property{
+		"shell": {Enum: []string{"bash", "zsh", "fish", "powershell"}},
+	},
 }
 
-// RegisterCompletionCompletionTool registers the gh completion completion tool
-func RegisterCompletionCompletionTool(server *mcp.Server, exec *executor.Executor) {
+// RegisterCompletionCompletionTool registers the gh completion tool
+func RegisterCompletionCompletionTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_completion_completion",
 		Description: "Generate shell completion scripts",
+		InputSchema: toolkit.InputSchema[CompletionCompletionArgs](completionCompletionInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Shell Completion Script",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(false),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args CompletionCompletionArgs) (*mcp.CallToolResult, any, error) {
-		cmd := []string{"completion", "completion"}
+		if err := completionCompletionInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh completion", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh completion", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh completion", nil, err)), nil, nil
+		}
+
+		cmd := []string{"completion"}
 
 		if args.Shell != "" {
 			cmd = append(cmd, "--shell", args.Shell)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_completion_completion", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh completion completion failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh completion", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterCompletionTools registers the gh completion tools
+func RegisterCompletionTools(server *mcp.Server, exec executor.Runner) {
+	RegisterCompletionCompletionTool(server, exec)
+}
@@ -0,0 +1,12 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestCompletionArgv calls every gh completion tool with representative
+// arguments and compares the commands they run with testdata/completion.golden
+func TestCompletionArgv(t *testing.T) {
+	checkArgvGolden(t, "completion", []argvCase{
+		{Tool: "gh_completion_completion", Args: map[string]any{"shell": "bash"}},
+	})
+}
@@ -2,39 +2,73 @@
 package generated
 
 import (
+	"cmp"
 	"context"
-	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // ConfigListArgs defines parameters for gh config list
 type ConfigListArgs struct {
 	Host string `json:"host,omitempty" jsonschema:"Get per-host configuration"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// configListInputSpec holds the argument constraints for gh config list
+var configListInputSpec = toolkit.InputSpec{}
+
 // RegisterConfigListTool registers the gh config list tool
-func RegisterConfigListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterConfigListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_config_list",
 		Description: "Print a list of configuration keys and values",
+		InputSchema: toolkit.InputSchema[ConfigListArgs](configListInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Configuration",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(false),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ConfigListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh config list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh config list", nil, err)), nil, nil
+		}
+
 		cmd := []string{"config", "list"}
 
 		if args.Host != "" {
 			cmd = append(cmd, "--host", args.Host)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_config_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh config list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh config list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -43,14 +77,44 @@ type ConfigGetArgs struct {
 	Host string `json:"host,omitempty" jsonschema:"Get per-host setting"`
 
 	Key string `json:"key,omitempty" jsonschema:"Configuration key (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// configGetInputSpec holds the argument constraints for gh config get
+var configGetInputSpec = toolkit.InputSpec{
+	Required: []string{"key"},
 }
 
 // RegisterConfigGetTool registers the gh config get tool
-func RegisterConfigGetTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterConfigGetTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_config_get",
 		Description: "Print the value of a given configuration key",
+		InputSchema: toolkit.InputSchema[ConfigGetArgs](configGetInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Get Configuration Value",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(false),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ConfigGetArgs) (*mcp.CallToolResult, any, error) {
+		if err := configGetInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh config get", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh config get", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh config get", nil, err)), nil, nil
+		}
+
 		cmd := []string{"config", "get"}
 
 		// Add positional argument: key
@@ -62,16 +126,23 @@ func RegisterConfigGetTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--host", args.Host)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_config_get", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh config get failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh config get", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -81,14 +152,44 @@ type ConfigSetArgs struct {
 
 	Key   string `json:"key,omitempty" jsonschema:"Configuration key (positional argument)"`
 	Value string `json:"value,omitempty" jsonschema:"Configuration value (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// configSetInputSpec holds the argument constraints for gh config set
+var configSetInputSpec = toolkit.InputSpec{
+	Required: []string{"key", "value"},
 }
 
 // RegisterConfigSetTool registers the gh config set tool
-func RegisterConfigSetTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterConfigSetTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_config_set",
 		Description: "Update configuration with a value for the given key",
+		InputSchema: toolkit.InputSchema[ConfigSetArgs](configSetInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Set Configuration Value",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(false),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ConfigSetArgs) (*mcp.CallToolResult, any, error) {
+		if err := configSetInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh config set", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh config set", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh config set", nil, err)), nil, nil
+		}
+
 		cmd := []string{"config", "set"}
 
 		// Add positional argument: key
@@ -105,40 +206,86 @@ func RegisterConfigSetTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--host", args.Host)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_config_set", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh config set failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh config set", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
 // ConfigClearCacheArgs defines parameters for gh config clear-cache
 type ConfigClearCacheArgs struct {
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// configClearCacheInputSpec holds the argument constraints for gh config clear-cache
+var configClearCacheInputSpec = toolkit.InputSpec{}
+
 // RegisterConfigClearCacheTool registers the gh config clear-cache tool
-func RegisterConfigClearCacheTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterConfigClearCacheTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_config_clear_cache",
 		Description: "Clear the cli cache",
+		InputSchema: toolkit.InputSchema[ConfigClearCacheArgs](configClearCacheInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Clear CLI Cache",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(false),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ConfigClearCacheArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh config clear-cache", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh config clear-cache", nil, err)), nil, nil
+		}
+
 		cmd := []string{"config", "clear-cache"}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_config_clear_cache", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh config clear-cache failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh config clear-cache", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterConfigTools registers the gh config tools
+func RegisterConfigTools(server *mcp.Server, exec executor.Runner) {
+	RegisterConfigListTool(server, exec)
+	RegisterConfigGetTool(server, exec)
+	RegisterConfigSetTool(server, exec)
+	RegisterConfigClearCacheTool(server, exec)
+}
@@ -0,0 +1,15 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestConfigArgv calls every gh config tool with representative
+// arguments and compares the commands they run with testdata/config.golden
+func TestConfigArgv(t *testing.T) {
+	checkArgvGolden(t, "config", []argvCase{
+		{Tool: "gh_config_list", Args: map[string]any{"host": "sample-host"}},
+		{Tool: "gh_config_get", Args: map[string]any{"key": "sample-key", "host": "sample-host"}},
+		{Tool: "gh_config_set", Args: map[string]any{"key": "sample-key", "value": "sample-value", "host": "sample-host"}},
+		{Tool: "gh_config_clear_cache", Args: map[string]any{}},
+	})
+}
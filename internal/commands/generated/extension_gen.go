@@ -2,34 +2,68 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // ExtensionListArgs defines parameters for gh extension list
 type ExtensionListArgs struct {
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// extensionListInputSpec holds the argument constraints for gh extension list
+var extensionListInputSpec = toolkit.InputSpec{}
+
 // RegisterExtensionListTool registers the gh extension list tool
-func RegisterExtensionListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterExtensionListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_extension_list",
 		Description: "List installed extension commands",
+		InputSchema: toolkit.InputSchema[ExtensionListArgs](extensionListInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Extensions",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(false),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ExtensionListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh extension list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh extension list", nil, err)), nil, nil
+		}
+
 		cmd := []string{"extension", "list"}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_extension_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh extension list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh extension list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -39,14 +73,44 @@ type ExtensionInstallArgs struct {
 	Pin   string `json:"pin,omitempty" jsonschema:"Pin extension to a release tag or commit ref"`
 
 	Repository string `json:"repository,omitempty" jsonschema:"Repository in OWNER/REPO format or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// extensionInstallInputSpec holds the argument constraints for gh extension install
+var extensionInstallInputSpec = toolkit.InputSpec{
+	Required: []string{"repository"},
 }
 
 // RegisterExtensionInstallTool registers the gh extension install tool
-func RegisterExtensionInstallTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterExtensionInstallTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_extension_install",
 		Description: "Install a gh extension from a repository",
+		InputSchema: toolkit.InputSchema[ExtensionInstallArgs](extensionInstallInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Install Extension",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ExtensionInstallArgs) (*mcp.CallToolResult, any, error) {
+		if err := extensionInstallInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh extension install", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh extension install", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh extension install", nil, err)), nil, nil
+		}
+
 		cmd := []string{"extension", "install"}
 
 		// Add positional argument: repository
@@ -62,30 +126,68 @@ func RegisterExtensionInstallTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--pin", args.Pin)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_extension_install", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh extension install failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh extension install", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
 // ExtensionRemoveArgs defines parameters for gh extension remove
 type ExtensionRemoveArgs struct {
 	Name string `json:"name,omitempty" jsonschema:"Name of the extension (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// extensionRemoveInputSpec holds the argument constraints for gh extension remove
+var extensionRemoveInputSpec = toolkit.InputSpec{
+	Required: []string{"name"},
 }
 
 // RegisterExtensionRemoveTool registers the gh extension remove tool
-func RegisterExtensionRemoveTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterExtensionRemoveTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_extension_remove",
 		Description: "Remove an installed extension",
+		InputSchema: toolkit.InputSchema[ExtensionRemoveArgs](extensionRemoveInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Remove Extension",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ExtensionRemoveArgs) (*mcp.CallToolResult, any, error) {
+		if err := extensionRemoveInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh extension remove", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh extension remove", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh extension remove", nil, err)), nil, nil
+		}
+
 		cmd := []string{"extension", "remove"}
 
 		// Add positional argument: name
@@ -93,16 +195,29 @@ func RegisterExtensionRemoveTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, args.Name)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh extension remove", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_extension_remove", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh extension remove failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh extension remove", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -113,14 +228,39 @@ type ExtensionUpgradeArgs struct {
 	Force  bool `json:"force,omitempty" jsonschema:"Force upgrade extension"`
 
 	Name string `json:"name,omitempty" jsonschema:"Name of the extension to upgrade (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// extensionUpgradeInputSpec holds the argument constraints for gh extension upgrade
+var extensionUpgradeInputSpec = toolkit.InputSpec{}
+
 // RegisterExtensionUpgradeTool registers the gh extension upgrade tool
-func RegisterExtensionUpgradeTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterExtensionUpgradeTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_extension_upgrade",
 		Description: "Upgrade installed extensions",
+		InputSchema: toolkit.InputSchema[ExtensionUpgradeArgs](extensionUpgradeInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Upgrade Extensions",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ExtensionUpgradeArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh extension upgrade", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh extension upgrade", nil, err)), nil, nil
+		}
+
 		cmd := []string{"extension", "upgrade"}
 
 		// Add positional argument: name
@@ -140,16 +280,23 @@ func RegisterExtensionUpgradeTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--force")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_extension_upgrade", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh extension upgrade failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh extension upgrade", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -166,14 +313,80 @@ type ExtensionSearchArgs struct {
 	Web      bool     `json:"web,omitempty" jsonschema:"Open the search query in the web browser"`
 
 	Query string `json:"query,omitempty" jsonschema:"Search query (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// extensionSearchInputSpec holds the argument constraints for gh extension search
+var extensionSearchInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"order": {Enum: []string{"asc", "desc"}},
+		"sort":  {Enum: []string{"forks", "help-wanted-issues", "stars", "updated", "best-match"}},
+	},
+}
+
+// extensionSearchOutputSpec describes the --json output of gh extension search
+var extensionSearchOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "createdAt", Type: "string", Description: "When the repository was created"},
+		{Name: "defaultBranch", Type: "string", Description: "Default branch name"},
+		{Name: "description", Type: "string", Description: "Repository description"},
+		{Name: "forksCount", Type: "integer", Description: "Number of forks"},
+		{Name: "fullName", Type: "string", Description: "Repository name in OWNER/REPO format"},
+		{Name: "hasDownloads", Type: "boolean", Description: "Whether downloads are enabled"},
+		{Name: "hasIssues", Type: "boolean", Description: "Whether issues are enabled"},
+		{Name: "hasPages", Type: "boolean", Description: "Whether Pages is enabled"},
+		{Name: "hasProjects", Type: "boolean", Description: "Whether projects are enabled"},
+		{Name: "hasWiki", Type: "boolean", Description: "Whether the wiki is enabled"},
+		{Name: "homepage", Type: "string", Description: "Homepage URL"},
+		{Name: "id", Type: "string", Description: "Node ID"},
+		{Name: "isArchived", Type: "boolean", Description: "Whether the repository is archived"},
+		{Name: "isDisabled", Type: "boolean", Description: "Whether the repository is disabled"},
+		{Name: "isFork", Type: "boolean", Description: "Whether the repository is a fork"},
+		{Name: "isPrivate", Type: "boolean", Description: "Whether the repository is private"},
+		{Name: "language", Type: "string", Description: "Primary language"},
+		{Name: "license", Type: "object", Description: "License"},
+		{Name: "name", Type: "string", Description: "Repository name"},
+		{Name: "openIssuesCount", Type: "integer", Description: "Number of open issues"},
+		{Name: "owner", Type: "object", Description: "Repository owner"},
+		{Name: "pushedAt", Type: "string", Description: "When the repository was last pushed to"},
+		{Name: "size", Type: "integer", Description: "Size in kilobytes"},
+		{Name: "stargazersCount", Type: "integer", Description: "Number of stars"},
+		{Name: "updatedAt", Type: "string", Description: "When the repository was last updated"},
+		{Name: "url", Type: "string", Description: "Repository URL"},
+		{Name: "visibility", Type: "string", Description: "Repository visibility"},
+		{Name: "watchersCount", Type: "integer", Description: "Number of watchers"},
+	},
 }
 
 // RegisterExtensionSearchTool registers the gh extension search tool
-func RegisterExtensionSearchTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterExtensionSearchTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_extension_search",
-		Description: "Search for gh extensions",
+		Name:         "gh_extension_search",
+		Description:  "Search for gh extensions",
+		InputSchema:  toolkit.InputSchema[ExtensionSearchArgs](extensionSearchInputSpec),
+		OutputSchema: toolkit.OutputSchema(extensionSearchOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Search Extensions",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ExtensionSearchArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh extension search", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh extension search", nil, err)), nil, nil
+		}
+
 		cmd := []string{"extension", "search"}
 
 		// Add positional argument: query
@@ -217,16 +430,23 @@ func RegisterExtensionSearchTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--web")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_extension_search", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh extension search failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh extension search", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -235,14 +455,43 @@ type ExtensionCreateArgs struct {
 	Precompiled string `json:"precompiled,omitempty" jsonschema:"Create a precompiled extension"`
 
 	Name string `json:"name,omitempty" jsonschema:"Name of the extension (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// extensionCreateInputSpec holds the argument constraints for gh extension create
+var extensionCreateInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"precompiled": {Enum: []string{"go", "other"}},
+	},
 }
 
 // RegisterExtensionCreateTool registers the gh extension create tool
-func RegisterExtensionCreateTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterExtensionCreateTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_extension_create",
 		Description: "Create a new extension",
+		InputSchema: toolkit.InputSchema[ExtensionCreateArgs](extensionCreateInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Create Extension",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(false),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ExtensionCreateArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh extension create", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh extension create", nil, err)), nil, nil
+		}
+
 		cmd := []string{"extension", "create"}
 
 		// Add positional argument: name
@@ -254,30 +503,67 @@ func RegisterExtensionCreateTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--precompiled", args.Precompiled)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_extension_create", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh extension create failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh extension create", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
 // ExtensionExecArgs defines parameters for gh extension exec
 type ExtensionExecArgs struct {
 	Name string `json:"name,omitempty" jsonschema:"Name of the extension to execute (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// extensionExecInputSpec holds the argument constraints for gh extension exec
+var extensionExecInputSpec = toolkit.InputSpec{
+	Required: []string{"name"},
 }
 
 // RegisterExtensionExecTool registers the gh extension exec tool
-func RegisterExtensionExecTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterExtensionExecTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_extension_exec",
 		Description: "Execute an installed extension",
+		InputSchema: toolkit.InputSchema[ExtensionExecArgs](extensionExecInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Run Extension",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(false),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ExtensionExecArgs) (*mcp.CallToolResult, any, error) {
+		if err := extensionExecInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh extension exec", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh extension exec", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh extension exec", nil, err)), nil, nil
+		}
+
 		cmd := []string{"extension", "exec"}
 
 		// Add positional argument: name
@@ -285,40 +571,90 @@ func RegisterExtensionExecTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, args.Name)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_extension_exec", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh extension exec failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh extension exec", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
 // ExtensionBrowseArgs defines parameters for gh extension browse
 type ExtensionBrowseArgs struct {
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// extensionBrowseInputSpec holds the argument constraints for gh extension browse
+var extensionBrowseInputSpec = toolkit.InputSpec{}
+
 // RegisterExtensionBrowseTool registers the gh extension browse tool
-func RegisterExtensionBrowseTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterExtensionBrowseTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_extension_browse",
 		Description: "Enter a UI for browsing, adding, and removing extensions",
+		InputSchema: toolkit.InputSchema[ExtensionBrowseArgs](extensionBrowseInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Browse Extensions",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ExtensionBrowseArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh extension browse", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh extension browse", nil, err)), nil, nil
+		}
+
 		cmd := []string{"extension", "browse"}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_extension_browse", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh extension browse failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh extension browse", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterExtensionTools registers the gh extension tools
+func RegisterExtensionTools(server *mcp.Server, exec executor.Runner) {
+	RegisterExtensionListTool(server, exec)
+	RegisterExtensionInstallTool(server, exec)
+	RegisterExtensionRemoveTool(server, exec)
+	RegisterExtensionUpgradeTool(server, exec)
+	RegisterExtensionSearchTool(server, exec)
+	RegisterExtensionCreateTool(server, exec)
+	RegisterExtensionExecTool(server, exec)
+	RegisterExtensionBrowseTool(server, exec)
+}
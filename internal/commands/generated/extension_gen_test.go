@@ -0,0 +1,19 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestExtensionArgv calls every gh extension tool with representative
+// arguments and compares the commands they run with testdata/extension.golden
+func TestExtensionArgv(t *testing.T) {
+	checkArgvGolden(t, "extension", []argvCase{
+		{Tool: "gh_extension_list", Args: map[string]any{}},
+		{Tool: "gh_extension_install", Args: map[string]any{"repository": "sample-repository", "force": true, "pin": "sample-pin"}},
+		{Tool: "gh_extension_remove", Args: map[string]any{"name": "sample-name"}},
+		{Tool: "gh_extension_upgrade", Args: map[string]any{"name": "sample-name", "all": true, "dry_run": true, "force": true}},
+		{Tool: "gh_extension_search", Args: map[string]any{"query": "sample-query", "license": []any{"sample-license"}, "limit": 1, "order": "asc", "owner": []any{"sample-owner"}, "sort": "forks", "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "web": true}},
+		{Tool: "gh_extension_create", Args: map[string]any{"name": "sample-name", "precompiled": "go"}},
+		{Tool: "gh_extension_exec", Args: map[string]any{"name": "sample-name"}},
+		{Tool: "gh_extension_browse", Args: map[string]any{}},
+	})
+}
@@ -2,9 +2,12 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -16,14 +19,39 @@ type GistCreateArgs struct {
 	Web      bool   `json:"web,omitempty" jsonschema:"Open in web browser"`
 
 	Files []string `json:"files,omitempty" jsonschema:"Files to include in gist (positional arguments)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// gistCreateInputSpec holds the argument constraints for gh gist create
+var gistCreateInputSpec = toolkit.InputSpec{}
+
 // RegisterGistCreateTool registers the gh gist create tool
-func RegisterGistCreateTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterGistCreateTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_gist_create",
 		Description: "Create a new gist",
+		InputSchema: toolkit.InputSchema[GistCreateArgs](gistCreateInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Create Gist",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args GistCreateArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh gist create", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh gist create", nil, err)), nil, nil
+		}
+
 		cmd := []string{"gist", "create"}
 
 		// Add positional argument: files
@@ -45,16 +73,23 @@ func RegisterGistCreateTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--web")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_gist_create", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh gist create failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh gist create", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -66,14 +101,39 @@ type GistListArgs struct {
 	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
 	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// gistListInputSpec holds the argument constraints for gh gist list
+var gistListInputSpec = toolkit.InputSpec{}
+
 // RegisterGistListTool registers the gh gist list tool
-func RegisterGistListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterGistListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_gist_list",
 		Description: "List gists owned by user",
+		InputSchema: toolkit.InputSchema[GistListArgs](gistListInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Gists",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args GistListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh gist list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh gist list", nil, err)), nil, nil
+		}
+
 		cmd := []string{"gist", "list"}
 
 		if args.Limit > 0 {
@@ -100,16 +160,23 @@ func RegisterGistListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--template", args.Template)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_gist_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh gist list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh gist list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -121,14 +188,44 @@ type GistViewArgs struct {
 	Web      bool   `json:"web,omitempty" jsonschema:"Open gist in the browser"`
 
 	Gist string `json:"gist,omitempty" jsonschema:"Gist ID or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// gistViewInputSpec holds the argument constraints for gh gist view
+var gistViewInputSpec = toolkit.InputSpec{
+	Required: []string{"gist"},
 }
 
 // RegisterGistViewTool registers the gh gist view tool
-func RegisterGistViewTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterGistViewTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_gist_view",
 		Description: "View a gist",
+		InputSchema: toolkit.InputSchema[GistViewArgs](gistViewInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "View Gist",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args GistViewArgs) (*mcp.CallToolResult, any, error) {
+		if err := gistViewInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh gist view", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh gist view", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh gist view", nil, err)), nil, nil
+		}
+
 		cmd := []string{"gist", "view"}
 
 		// Add positional argument: gist
@@ -152,16 +249,23 @@ func RegisterGistViewTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--web")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_gist_view", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh gist view failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh gist view", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -173,14 +277,44 @@ type GistEditArgs struct {
 	Remove   []string `json:"remove,omitempty" jsonschema:"Remove a file from the gist"`
 
 	Gist string `json:"gist,omitempty" jsonschema:"Gist ID or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// gistEditInputSpec holds the argument constraints for gh gist edit
+var gistEditInputSpec = toolkit.InputSpec{
+	Required: []string{"gist"},
 }
 
 // RegisterGistEditTool registers the gh gist edit tool
-func RegisterGistEditTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterGistEditTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_gist_edit",
 		Description: "Edit a gist",
+		InputSchema: toolkit.InputSchema[GistEditArgs](gistEditInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Edit Gist",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args GistEditArgs) (*mcp.CallToolResult, any, error) {
+		if err := gistEditInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh gist edit", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh gist edit", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh gist edit", nil, err)), nil, nil
+		}
+
 		cmd := []string{"gist", "edit"}
 
 		// Add positional argument: gist
@@ -204,30 +338,68 @@ func RegisterGistEditTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--remove", v)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_gist_edit", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh gist edit failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh gist edit", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
 // GistDeleteArgs defines parameters for gh gist delete
 type GistDeleteArgs struct {
 	Gist string `json:"gist,omitempty" jsonschema:"Gist ID or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// gistDeleteInputSpec holds the argument constraints for gh gist delete
+var gistDeleteInputSpec = toolkit.InputSpec{
+	Required: []string{"gist"},
 }
 
 // RegisterGistDeleteTool registers the gh gist delete tool
-func RegisterGistDeleteTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterGistDeleteTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_gist_delete",
 		Description: "Delete a gist",
+		InputSchema: toolkit.InputSchema[GistDeleteArgs](gistDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete Gist",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args GistDeleteArgs) (*mcp.CallToolResult, any, error) {
+		if err := gistDeleteInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh gist delete", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh gist delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh gist delete", nil, err)), nil, nil
+		}
+
 		cmd := []string{"gist", "delete"}
 
 		// Add positional argument: gist
@@ -235,16 +407,29 @@ func RegisterGistDeleteTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, args.Gist)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh gist delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_gist_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh gist delete failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh gist delete", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -252,14 +437,44 @@ func RegisterGistDeleteTool(server *mcp.Server, exec *executor.Executor) {
 type GistCloneArgs struct {
 	Gist      string `json:"gist,omitempty" jsonschema:"Gist ID or URL (positional argument)"`
 	Directory string `json:"directory,omitempty" jsonschema:"Directory to clone into (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// gistCloneInputSpec holds the argument constraints for gh gist clone
+var gistCloneInputSpec = toolkit.InputSpec{
+	Required: []string{"gist"},
 }
 
 // RegisterGistCloneTool registers the gh gist clone tool
-func RegisterGistCloneTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterGistCloneTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_gist_clone",
 		Description: "Clone a gist locally",
+		InputSchema: toolkit.InputSchema[GistCloneArgs](gistCloneInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Clone Gist",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args GistCloneArgs) (*mcp.CallToolResult, any, error) {
+		if err := gistCloneInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh gist clone", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh gist clone", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh gist clone", nil, err)), nil, nil
+		}
+
 		cmd := []string{"gist", "clone"}
 
 		// Add positional argument: gist
@@ -272,15 +487,32 @@ func RegisterGistCloneTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, args.Directory)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_gist_clone", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh gist clone failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh gist clone", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterGistTools registers the gh gist tools
+func RegisterGistTools(server *mcp.Server, exec executor.Runner) {
+	RegisterGistCreateTool(server, exec)
+	RegisterGistListTool(server, exec)
+	RegisterGistViewTool(server, exec)
+	RegisterGistEditTool(server, exec)
+	RegisterGistDeleteTool(server, exec)
+	RegisterGistCloneTool(server, exec)
+}
@@ -0,0 +1,17 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestGistArgv calls every gh gist tool with representative
+// arguments and compares the commands they run with testdata/gist.golden
+func TestGistArgv(t *testing.T) {
+	checkArgvGolden(t, "gist", []argvCase{
+		{Tool: "gh_gist_create", Args: map[string]any{"files": []any{"sample-files"}, "desc": "sample-desc", "filename": "sample-filename", "public": true, "web": true}},
+		{Tool: "gh_gist_list", Args: map[string]any{"limit": 1, "public": true, "secret": true, "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template"}},
+		{Tool: "gh_gist_view", Args: map[string]any{"gist": "sample-gist", "filename": "sample-filename", "files": true, "raw": true, "web": true}},
+		{Tool: "gh_gist_edit", Args: map[string]any{"gist": "sample-gist", "add": []any{"sample-add"}, "desc": "sample-desc", "filename": "sample-filename", "remove": []any{"sample-remove"}}},
+		{Tool: "gh_gist_delete", Args: map[string]any{"gist": "sample-gist"}},
+		{Tool: "gh_gist_clone", Args: map[string]any{"gist": "sample-gist", "directory": "sample-directory"}},
+	})
+}
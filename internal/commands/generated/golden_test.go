@@ -0,0 +1,65 @@
+package generated
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/executor/executortest"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
+)
+
+var update = flag.Bool("update", false, "rewrite the argv golden files in testdata")
+
+// argvCase is one tool call of a generated argv golden test.
+type argvCase struct {
+	Tool string
+	Args map[string]any
+}
+
+// checkArgvGolden calls each tool through a recording runner and compares
+// the commands they run with testdata/<command>.golden, one line per tool.
+// Run go test ./internal/commands/generated -update to rewrite the file
+// after changing a definition.
+func checkArgvGolden(t *testing.T, command string, cases []argvCase) {
+	t.Helper()
+	exec := executortest.NewRunner()
+	server := mcp.NewServer(&mcp.Implementation{Name: "mcp-go-gh-test", Version: "test"}, nil)
+	RegisterAllTools(server, exec)
+	cs := connect(t, server)
+
+	var got strings.Builder
+	for _, c := range cases {
+		exec.Reset()
+		result, err := cs.CallTool(context.Background(), &mcp.CallToolParams{Name: c.Tool, Arguments: c.Args})
+		require.NoError(t, err, c.Tool)
+		require.False(t, result.IsError, "%s: %+v", c.Tool, result.Content)
+
+		call, ok := exec.LastCall()
+		require.True(t, ok, "%s ran no command", c.Tool)
+		fmt.Fprintf(&got, "%s: %s", c.Tool, toolkit.CommandLine(call.Argv))
+		if call.Options.Stdin != "" {
+			fmt.Fprintf(&got, " <<< %s", strconv.Quote(call.Options.Stdin))
+		}
+		got.WriteString("\n")
+	}
+
+	path := filepath.Join("testdata", command+".golden")
+	if *update {
+		require.NoError(t, os.MkdirAll("testdata", 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(got.String()), 0o600))
+		return
+	}
+	want, err := os.ReadFile(path)
+	require.NoError(t, err, "run go test ./internal/commands/generated -update to create it")
+	assert.Equal(t, string(want), got.String(), "argv changed; if intended, run go test ./internal/commands/generated -update")
+}
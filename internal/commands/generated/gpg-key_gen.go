@@ -2,34 +2,67 @@
 package generated
 
 import (
+	"cmp"
 	"context"
-	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // GpgKeyListArgs defines parameters for gh gpg-key list
 type GpgKeyListArgs struct {
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// gpgKeyListInputSpec holds the argument constraints for gh gpg-key list
+var gpgKeyListInputSpec = toolkit.InputSpec{}
+
 // RegisterGpgKeyListTool registers the gh gpg-key list tool
-func RegisterGpgKeyListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterGpgKeyListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_gpg-key_list",
 		Description: "Lists GPG keys in your GitHub account",
+		InputSchema: toolkit.InputSchema[GpgKeyListArgs](gpgKeyListInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List GPG Keys",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args GpgKeyListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh gpg-key list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh gpg-key list", nil, err)), nil, nil
+		}
+
 		cmd := []string{"gpg-key", "list"}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_gpg-key_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh gpg-key list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh gpg-key list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -38,14 +71,39 @@ type GpgKeyAddArgs struct {
 	Title string `json:"title,omitempty" jsonschema:"Title for the new key"`
 
 	KeyFile string `json:"key_file,omitempty" jsonschema:"Path to GPG key file (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// gpgKeyAddInputSpec holds the argument constraints for gh gpg-key add
+var gpgKeyAddInputSpec = toolkit.InputSpec{}
+
 // RegisterGpgKeyAddTool registers the gh gpg-key add tool
-func RegisterGpgKeyAddTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterGpgKeyAddTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_gpg-key_add",
 		Description: "Add a GPG key to your GitHub account",
+		InputSchema: toolkit.InputSchema[GpgKeyAddArgs](gpgKeyAddInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Add GPG Key",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args GpgKeyAddArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh gpg-key add", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh gpg-key add", nil, err)), nil, nil
+		}
+
 		cmd := []string{"gpg-key", "add"}
 
 		// Add positional argument: key_file
@@ -57,16 +115,23 @@ func RegisterGpgKeyAddTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--title", args.Title)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_gpg-key_add", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh gpg-key add failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh gpg-key add", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -75,14 +140,45 @@ type GpgKeyDeleteArgs struct {
 	Yes bool `json:"yes,omitempty" jsonschema:"Skip the confirmation prompt"`
 
 	KeyId string `json:"key_id,omitempty" jsonschema:"GPG key ID (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// gpgKeyDeleteInputSpec holds the argument constraints for gh gpg-key delete
+var gpgKeyDeleteInputSpec = toolkit.InputSpec{
+	Required: []string{"key_id"},
 }
 
 // RegisterGpgKeyDeleteTool registers the gh gpg-key delete tool
-func RegisterGpgKeyDeleteTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterGpgKeyDeleteTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_gpg-key_delete",
 		Description: "Delete a GPG key from your GitHub account",
+		InputSchema: toolkit.InputSchema[GpgKeyDeleteArgs](gpgKeyDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete GPG Key",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args GpgKeyDeleteArgs) (*mcp.CallToolResult, any, error) {
+		if err := gpgKeyDeleteInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh gpg-key delete", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh gpg-key delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh gpg-key delete", nil, err)), nil, nil
+		}
+
 		cmd := []string{"gpg-key", "delete"}
 
 		// Add positional argument: key_id
@@ -94,15 +190,35 @@ func RegisterGpgKeyDeleteTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--yes")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh gpg-key delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_gpg-key_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh gpg-key delete failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh gpg-key delete", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterGpgKeyTools registers the gh gpg-key tools
+func RegisterGpgKeyTools(server *mcp.Server, exec executor.Runner) {
+	RegisterGpgKeyListTool(server, exec)
+	RegisterGpgKeyAddTool(server, exec)
+	RegisterGpgKeyDeleteTool(server, exec)
+}
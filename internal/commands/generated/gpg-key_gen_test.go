@@ -0,0 +1,14 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestGpgKeyArgv calls every gh gpg-key tool with representative
+// arguments and compares the commands they run with testdata/gpg-key.golden
+func TestGpgKeyArgv(t *testing.T) {
+	checkArgvGolden(t, "gpg-key", []argvCase{
+		{Tool: "gh_gpg-key_list", Args: map[string]any{}},
+		{Tool: "gh_gpg-key_add", Args: map[string]any{"key_file": "sample-key-file", "title": "sample-title"}},
+		{Tool: "gh_gpg-key_delete", Args: map[string]any{"key_id": "sample-key-id", "yes": true}},
+	})
+}
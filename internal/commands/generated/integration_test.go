@@ -1,27 +1,22 @@
 package generated
 
 import (
-	"log/slog"
-	"os"
+	"context"
+	"strings"
 	"testing"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/executor/executortest"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolset"
 )
 
 // TestRegisterAllTools verifies that all tools register without errors.
 func TestRegisterAllTools(t *testing.T) {
-	// Create test logger
-	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
-		Level: slog.LevelError,
-	}))
-
-	// Create executor
-	exec, err := executor.New(logger)
-	require.NoError(t, err, "executor creation should not fail")
+	// Create a fake runner, so that no gh binary is needed
+	exec := executortest.NewRunner()
 
 	// Create MCP server
 	impl := &mcp.Implementation{
@@ -44,12 +39,7 @@ func TestRegisterAllTools(t *testing.T) {
 func TestToolCount(t *testing.T) {
 	// This test helps catch regressions where tools are accidentally removed
 
-	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
-		Level: slog.LevelError,
-	}))
-
-	exec, err := executor.New(logger)
-	require.NoError(t, err)
+	exec := executortest.NewRunner()
 
 	impl := &mcp.Implementation{
 		Name:    "mcp-go-gh-test",
@@ -60,21 +50,11 @@ func TestToolCount(t *testing.T) {
 
 	RegisterAllTools(server, exec)
 
-	// We expect 152 tools based on our 27 command groups
+	// We expect 164 tools based on our 27 command groups
 	// If this fails, it means tools were added or removed
-	expectedToolCount := 152
-
-	// Get the actual tool count
-	// Note: We can't directly count tools from the server object as it's opaque,
-	// but we can verify the registration completed without errors
-	// and document the expected count for manual verification
-
-	t.Logf("Expected tool count: %d", expectedToolCount)
-	t.Log("All tools registered successfully")
-
-	// This serves as documentation of the current tool count
-	// If the count changes, this test should be updated along with README
-	assert.True(t, true, "Tool registration completed - manual count verification needed")
+	tools, err := connect(t, server).ListTools(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Len(t, tools.Tools, 164, "update this test and the README when tools are added or removed")
 }
 
 // TestToolNaming verifies that tool names follow the expected convention.
@@ -124,3 +104,133 @@ func TestCommandGroups(t *testing.T) {
 		t.Logf("  - %s", group)
 	}
 }
+
+// TestToolsets verifies that the toolsets list every tool exactly once, under
+// its command group.
+func TestToolsets(t *testing.T) {
+	assert.Len(t, Toolsets, 27, "one toolset per command group")
+
+	seen := make(map[string]bool)
+	for _, ts := range Toolsets {
+		assert.NotEmpty(t, ts.Tools, "toolset %s should have tools", ts.Name)
+		prefix := "gh_" + ts.Name + "_"
+		for _, tool := range ts.Tools {
+			assert.True(t, strings.HasPrefix(tool.Name, prefix), "tool %s should belong to toolset %s", tool.Name, ts.Name)
+			assert.False(t, seen[tool.Name], "tool %s listed twice", tool.Name)
+			assert.NotNil(t, tool.Register, "tool %s should have a register function", tool.Name)
+			assert.NotEmpty(t, tool.Access, "tool %s should declare its access", tool.Name)
+			seen[tool.Name] = true
+		}
+	}
+	assert.Len(t, seen, 164)
+}
+
+// TestReadOnlyTools verifies which tools a read-only server registers.
+func TestReadOnlyTools(t *testing.T) {
+	selection := toolset.Selection{ReadOnly: true}
+
+	included := make(map[string]bool)
+	for _, ts := range Toolsets {
+		for _, tool := range ts.Tools {
+			if selection.Includes(ts.Name, tool) {
+				included[tool.Name] = true
+				assert.True(t, tool.Access == toolset.AccessRead || tool.ReadOnlyConstrained, tool.Name)
+				assert.False(t, tool.SensitiveOutput, tool.Name)
+			}
+		}
+	}
+
+	for _, name := range []string{"gh_pr_list", "gh_repo_view", "gh_run_watch", "gh_search_issues", "gh_api_request"} {
+		assert.True(t, included[name], "%s should be available read-only", name)
+	}
+	for _, name := range []string{"gh_pr_create", "gh_pr_merge", "gh_repo_clone", "gh_repo_delete", "gh_secret_set", "gh_auth_login", "gh_auth_token"} {
+		assert.False(t, included[name], "%s should not be available read-only", name)
+	}
+
+	// No read-only tool takes an argument that makes gh print a token,
+	// such as gh auth status --show-token.
+	server := mcp.NewServer(&mcp.Implementation{Name: "mcp-go-gh-test", Version: "test"}, nil)
+	toolset.Register(server, executortest.NewRunner(), Toolsets, selection)
+	tools, err := connect(t, server).ListTools(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, tools.Tools, len(included))
+	for _, tool := range tools.Tools {
+		schema, ok := tool.InputSchema.(map[string]any)
+		require.True(t, ok, tool.Name)
+		properties, _ := schema["properties"].(map[string]any)
+		for name := range properties {
+			if name != "confirm_token" {
+				assert.NotContains(t, name, "token", "%s: read-only tools must not print credentials", tool.Name)
+			}
+		}
+	}
+}
+
+// TestToolAnnotations verifies that every tool advertises a title and hints
+// matching its access level.
+func TestToolAnnotations(t *testing.T) {
+	exec := executortest.NewRunner()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "mcp-go-gh-test", Version: "test"}, nil)
+	RegisterAllTools(server, exec)
+	clientSession := connect(t, server)
+	ctx := context.Background()
+
+	access := make(map[string]toolset.Access)
+	for _, ts := range Toolsets {
+		for _, tool := range ts.Tools {
+			access[tool.Name] = tool.Access
+		}
+	}
+
+	tools, err := clientSession.ListTools(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, tools.Tools, len(access))
+
+	for _, tool := range tools.Tools {
+		annotations := tool.Annotations
+		require.NotNil(t, annotations, tool.Name)
+		assert.NotEmpty(t, annotations.Title, tool.Name)
+		assert.Equal(t, access[tool.Name] == toolset.AccessRead, annotations.ReadOnlyHint, tool.Name)
+		require.NotNil(t, annotations.DestructiveHint, tool.Name)
+		if access[tool.Name] != toolset.AccessWrite {
+			assert.Equal(t, access[tool.Name] == toolset.AccessDestructive, *annotations.DestructiveHint, tool.Name)
+		}
+		require.NotNil(t, annotations.OpenWorldHint, tool.Name)
+	}
+
+	byName := make(map[string]*mcp.Tool)
+	for _, tool := range tools.Tools {
+		byName[tool.Name] = tool
+	}
+	assert.Equal(t, "Create Pull Request", byName["gh_pr_create"].Annotations.Title)
+	assert.True(t, byName["gh_issue_close"].Annotations.IdempotentHint)
+	assert.False(t, byName["gh_issue_create"].Annotations.IdempotentHint)
+	assert.True(t, *byName["gh_repo_view"].Annotations.OpenWorldHint)
+	assert.False(t, *byName["gh_alias_list"].Annotations.OpenWorldHint)
+
+	// Writes are destructive unless their definition marks them additive.
+	for _, name := range []string{"gh_api_request", "gh_repo_edit", "gh_config_set", "gh_secret_set", "gh_issue_edit"} {
+		assert.True(t, *byName[name].Annotations.DestructiveHint, name)
+	}
+	for _, name := range []string{"gh_issue_create", "gh_pr_comment", "gh_ssh-key_add"} {
+		assert.False(t, *byName[name].Annotations.DestructiveHint, name)
+	}
+}
+
+// connect returns a client session connected to server in memory.
+func connect(t *testing.T, server *mcp.Server) *mcp.ClientSession {
+	t.Helper()
+	ctx := context.Background()
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "test"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+	return clientSession
+}
@@ -2,9 +2,12 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -21,14 +24,39 @@ type IssueCreateArgs struct {
 	Web       bool     `json:"web,omitempty" jsonschema:"Open the web browser to create an issue"`
 	Recover   string   `json:"recover,omitempty" jsonschema:"Recover input from a failed run"`
 	Repo      string   `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// issueCreateInputSpec holds the argument constraints for gh issue create
+var issueCreateInputSpec = toolkit.InputSpec{}
+
 // RegisterIssueCreateTool registers the gh issue create tool
-func RegisterIssueCreateTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterIssueCreateTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_issue_create",
 		Description: "Create a new issue",
+		InputSchema: toolkit.InputSchema[IssueCreateArgs](issueCreateInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Create Issue",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args IssueCreateArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh issue create", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh issue create", nil, err)), nil, nil
+		}
+
 		cmd := []string{"issue", "create"}
 
 		if args.Title != "" {
@@ -75,16 +103,23 @@ func RegisterIssueCreateTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_issue_create", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh issue create failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh issue create", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -99,19 +134,86 @@ type IssueListArgs struct {
 	Search    string   `json:"search,omitempty" jsonschema:"Search issues with a query"`
 	App       string   `json:"app,omitempty" jsonschema:"Filter by GitHub App author"`
 	Limit     int      `json:"limit,omitempty" jsonschema:"Maximum number of items to fetch"`
-	Json      []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json      []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: number,title,state,author,labels,assignees,createdAt,updatedAt,url)"`
 	Jq        string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template  string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Web       bool     `json:"web,omitempty" jsonschema:"List issues in the web browser"`
 	Repo      string   `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
+	Format    string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// issueListInputSpec holds the argument constraints for gh issue list
+var issueListInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"state":  {Enum: []string{"open", "closed", "all"}, Default: "open"},
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
+}
+
+// issueListOutputSpec describes the --json output of gh issue list
+var issueListOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "assignees", Type: "array", Description: "Assigned users"},
+		{Name: "author", Type: "object", Description: "Issue author"},
+		{Name: "body", Type: "string", Description: "Issue body"},
+		{Name: "closed", Type: "boolean", Description: "Whether the issue is closed"},
+		{Name: "closedAt", Type: "string", Description: "When the issue was closed", Nullable: true},
+		{Name: "closedByPullRequestsReferences", Type: "array", Description: "Pull requests that closed the issue"},
+		{Name: "comments", Type: "array", Description: "Issue comments"},
+		{Name: "createdAt", Type: "string", Description: "When the issue was created"},
+		{Name: "id", Type: "string", Description: "Node ID"},
+		{Name: "isPinned", Type: "boolean", Description: "Whether the issue is pinned"},
+		{Name: "labels", Type: "array", Description: "Applied labels"},
+		{Name: "milestone", Type: "object", Description: "Milestone", Nullable: true},
+		{Name: "number", Type: "integer", Description: "Issue number"},
+		{Name: "projectCards", Type: "array", Description: "Classic project cards"},
+		{Name: "projectItems", Type: "array", Description: "Project items"},
+		{Name: "reactionGroups", Type: "array", Description: "Reactions grouped by content"},
+		{Name: "state", Type: "string", Description: "Issue state"},
+		{Name: "stateReason", Type: "string", Description: "Reason for the issue state"},
+		{Name: "title", Type: "string", Description: "Issue title"},
+		{Name: "updatedAt", Type: "string", Description: "When the issue was last updated"},
+		{Name: "url", Type: "string", Description: "Issue URL"},
+	},
+}
+
+// issueListDefaultJSONFields are requested when no json fields are given
+var issueListDefaultJSONFields = []string{"number", "title", "state", "author", "labels", "assignees", "createdAt", "updatedAt", "url"}
+
 // RegisterIssueListTool registers the gh issue list tool
-func RegisterIssueListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterIssueListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_issue_list",
-		Description: "List issues in a repository",
+		Name:         "gh_issue_list",
+		Description:  "List issues in a repository",
+		InputSchema:  toolkit.InputSchema[IssueListArgs](issueListInputSpec),
+		OutputSchema: toolkit.OutputSchema(issueListOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Issues",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args IssueListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh issue list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh issue list", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" && !args.Web {
+			args.Json = issueListDefaultJSONFields
+		}
+
 		cmd := []string{"issue", "list"}
 
 		if args.Assignee != "" {
@@ -170,37 +272,109 @@ func RegisterIssueListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_issue_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh issue list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh issue list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
 // IssueViewArgs defines parameters for gh issue view
 type IssueViewArgs struct {
 	Comments bool     `json:"comments,omitempty" jsonschema:"View issue comments"`
-	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: number,title,state,author,body,labels,assignees,milestone,createdAt,updatedAt,closedAt,url)"`
 	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Web      bool     `json:"web,omitempty" jsonschema:"Open issue in the browser"`
 	Repo     string   `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
+	Format   string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
 
 	Number string `json:"number,omitempty" jsonschema:"Issue number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// issueViewInputSpec holds the argument constraints for gh issue view
+var issueViewInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
+}
+
+// issueViewOutputSpec describes the --json output of gh issue view
+var issueViewOutputSpec = toolkit.OutputSpec{
+	Fields: []toolkit.OutputField{
+		{Name: "assignees", Type: "array", Description: "Assigned users"},
+		{Name: "author", Type: "object", Description: "Issue author"},
+		{Name: "body", Type: "string", Description: "Issue body"},
+		{Name: "closed", Type: "boolean", Description: "Whether the issue is closed"},
+		{Name: "closedAt", Type: "string", Description: "When the issue was closed", Nullable: true},
+		{Name: "closedByPullRequestsReferences", Type: "array", Description: "Pull requests that closed the issue"},
+		{Name: "comments", Type: "array", Description: "Issue comments"},
+		{Name: "createdAt", Type: "string", Description: "When the issue was created"},
+		{Name: "id", Type: "string", Description: "Node ID"},
+		{Name: "isPinned", Type: "boolean", Description: "Whether the issue is pinned"},
+		{Name: "labels", Type: "array", Description: "Applied labels"},
+		{Name: "milestone", Type: "object", Description: "Milestone", Nullable: true},
+		{Name: "number", Type: "integer", Description: "Issue number"},
+		{Name: "projectCards", Type: "array", Description: "Classic project cards"},
+		{Name: "projectItems", Type: "array", Description: "Project items"},
+		{Name: "reactionGroups", Type: "array", Description: "Reactions grouped by content"},
+		{Name: "state", Type: "string", Description: "Issue state"},
+		{Name: "stateReason", Type: "string", Description: "Reason for the issue state"},
+		{Name: "title", Type: "string", Description: "Issue title"},
+		{Name: "updatedAt", Type: "string", Description: "When the issue was last updated"},
+		{Name: "url", Type: "string", Description: "Issue URL"},
+	},
+}
+
+// issueViewDefaultJSONFields are requested when no json fields are given
+var issueViewDefaultJSONFields = []string{"number", "title", "state", "author", "body", "labels", "assignees", "milestone", "createdAt", "updatedAt", "closedAt", "url"}
+
 // RegisterIssueViewTool registers the gh issue view tool
-func RegisterIssueViewTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterIssueViewTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_issue_view",
-		Description: "View an issue",
+		Name:         "gh_issue_view",
+		Description:  "View an issue",
+		InputSchema:  toolkit.InputSchema[IssueViewArgs](issueViewInputSpec),
+		OutputSchema: toolkit.OutputSchema(issueViewOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "View Issue",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args IssueViewArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh issue view", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh issue view", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" && !args.Web && !args.Comments {
+			args.Json = issueViewDefaultJSONFields
+		}
+
 		cmd := []string{"issue", "view"}
 
 		// Add positional argument: number
@@ -232,16 +406,23 @@ func RegisterIssueViewTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_issue_view", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh issue view failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh issue view", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, false)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -252,14 +433,43 @@ type IssueCloseArgs struct {
 	Repo    string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Issue number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// issueCloseInputSpec holds the argument constraints for gh issue close
+var issueCloseInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"reason": {Enum: []string{"completed", "not planned"}},
+	},
 }
 
 // RegisterIssueCloseTool registers the gh issue close tool
-func RegisterIssueCloseTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterIssueCloseTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_issue_close",
 		Description: "Close an issue",
+		InputSchema: toolkit.InputSchema[IssueCloseArgs](issueCloseInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Close Issue",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args IssueCloseArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh issue close", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh issue close", nil, err)), nil, nil
+		}
+
 		cmd := []string{"issue", "close"}
 
 		// Add positional argument: number
@@ -279,16 +489,23 @@ func RegisterIssueCloseTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_issue_close", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh issue close failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh issue close", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -301,14 +518,39 @@ type IssueCommentArgs struct {
 	Repo     string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Issue number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// issueCommentInputSpec holds the argument constraints for gh issue comment
+var issueCommentInputSpec = toolkit.InputSpec{}
+
 // RegisterIssueCommentTool registers the gh issue comment tool
-func RegisterIssueCommentTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterIssueCommentTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_issue_comment",
 		Description: "Add a comment to an issue",
+		InputSchema: toolkit.InputSchema[IssueCommentArgs](issueCommentInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Comment on Issue",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args IssueCommentArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh issue comment", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh issue comment", nil, err)), nil, nil
+		}
+
 		cmd := []string{"issue", "comment"}
 
 		// Add positional argument: number
@@ -336,16 +578,23 @@ func RegisterIssueCommentTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_issue_comment", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh issue comment failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh issue comment", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -355,14 +604,40 @@ type IssueDeleteArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Issue number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
 }
 
+// issueDeleteInputSpec holds the argument constraints for gh issue delete
+var issueDeleteInputSpec = toolkit.InputSpec{}
+
 // RegisterIssueDeleteTool registers the gh issue delete tool
-func RegisterIssueDeleteTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterIssueDeleteTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_issue_delete",
 		Description: "Delete an issue",
+		InputSchema: toolkit.InputSchema[IssueDeleteArgs](issueDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete Issue",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args IssueDeleteArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh issue delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh issue delete", nil, err)), nil, nil
+		}
+
 		cmd := []string{"issue", "delete"}
 
 		// Add positional argument: number
@@ -378,16 +653,29 @@ func RegisterIssueDeleteTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh issue delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_issue_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh issue delete failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh issue delete", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -406,14 +694,39 @@ type IssueEditArgs struct {
 	Repo           string   `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Issue number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// issueEditInputSpec holds the argument constraints for gh issue edit
+var issueEditInputSpec = toolkit.InputSpec{}
+
 // RegisterIssueEditTool registers the gh issue edit tool
-func RegisterIssueEditTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterIssueEditTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_issue_edit",
 		Description: "Edit an issue",
+		InputSchema: toolkit.InputSchema[IssueEditArgs](issueEditInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Edit Issue",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args IssueEditArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh issue edit", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh issue edit", nil, err)), nil, nil
+		}
+
 		cmd := []string{"issue", "edit"}
 
 		// Add positional argument: number
@@ -465,16 +778,23 @@ func RegisterIssueEditTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_issue_edit", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh issue edit failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh issue edit", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -484,14 +804,43 @@ type IssueLockArgs struct {
 	Repo   string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Issue number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// issueLockInputSpec holds the argument constraints for gh issue lock
+var issueLockInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"reason": {Enum: []string{"off-topic", "spam", "resolved", "too heated"}},
+	},
 }
 
 // RegisterIssueLockTool registers the gh issue lock tool
-func RegisterIssueLockTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterIssueLockTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_issue_lock",
 		Description: "Lock issue conversation",
+		InputSchema: toolkit.InputSchema[IssueLockArgs](issueLockInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Lock Issue",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args IssueLockArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh issue lock", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh issue lock", nil, err)), nil, nil
+		}
+
 		cmd := []string{"issue", "lock"}
 
 		// Add positional argument: number
@@ -507,16 +856,23 @@ func RegisterIssueLockTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_issue_lock", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh issue lock failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh issue lock", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -525,14 +881,39 @@ type IssuePinArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Issue number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// issuePinInputSpec holds the argument constraints for gh issue pin
+var issuePinInputSpec = toolkit.InputSpec{}
+
 // RegisterIssuePinTool registers the gh issue pin tool
-func RegisterIssuePinTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterIssuePinTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_issue_pin",
 		Description: "Pin an issue to a repository",
+		InputSchema: toolkit.InputSchema[IssuePinArgs](issuePinInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Pin Issue",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args IssuePinArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh issue pin", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh issue pin", nil, err)), nil, nil
+		}
+
 		cmd := []string{"issue", "pin"}
 
 		// Add positional argument: number
@@ -544,16 +925,23 @@ func RegisterIssuePinTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_issue_pin", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh issue pin failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh issue pin", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -563,14 +951,39 @@ type IssueReopenArgs struct {
 	Repo    string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Issue number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// issueReopenInputSpec holds the argument constraints for gh issue reopen
+var issueReopenInputSpec = toolkit.InputSpec{}
+
 // RegisterIssueReopenTool registers the gh issue reopen tool
-func RegisterIssueReopenTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterIssueReopenTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_issue_reopen",
 		Description: "Reopen a closed issue",
+		InputSchema: toolkit.InputSchema[IssueReopenArgs](issueReopenInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Reopen Issue",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args IssueReopenArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh issue reopen", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh issue reopen", nil, err)), nil, nil
+		}
+
 		cmd := []string{"issue", "reopen"}
 
 		// Add positional argument: number
@@ -586,16 +999,23 @@ func RegisterIssueReopenTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_issue_reopen", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh issue reopen failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh issue reopen", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -605,14 +1025,49 @@ type IssueStatusArgs struct {
 	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Repo     string   `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// issueStatusInputSpec holds the argument constraints for gh issue status
+var issueStatusInputSpec = toolkit.InputSpec{}
+
+// issueStatusOutputSpec describes the --json output of gh issue status
+var issueStatusOutputSpec = toolkit.OutputSpec{
+	Fields: []toolkit.OutputField{
+		{Name: "assigned", Type: "array", Description: "Issues assigned to you"},
+		{Name: "authored", Type: "array", Description: "Issues opened by you"},
+		{Name: "mentioned", Type: "array", Description: "Issues mentioning you"},
+	},
 }
 
 // RegisterIssueStatusTool registers the gh issue status tool
-func RegisterIssueStatusTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterIssueStatusTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_issue_status",
-		Description: "Show status of relevant issues",
+		Name:         "gh_issue_status",
+		Description:  "Show status of relevant issues",
+		InputSchema:  toolkit.InputSchema[IssueStatusArgs](issueStatusInputSpec),
+		OutputSchema: toolkit.OutputSchema(issueStatusOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Issue Status",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args IssueStatusArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh issue status", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh issue status", nil, err)), nil, nil
+		}
+
 		cmd := []string{"issue", "status"}
 
 		if args.Jq != "" {
@@ -631,16 +1086,23 @@ func RegisterIssueStatusTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_issue_status", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh issue status failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh issue status", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, false)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -650,14 +1112,39 @@ type IssueTransferArgs struct {
 
 	Number      string `json:"number,omitempty" jsonschema:"Issue number or URL (positional argument)"`
 	Destination string `json:"destination,omitempty" jsonschema:"Destination repository in OWNER/REPO format (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// issueTransferInputSpec holds the argument constraints for gh issue transfer
+var issueTransferInputSpec = toolkit.InputSpec{}
+
 // RegisterIssueTransferTool registers the gh issue transfer tool
-func RegisterIssueTransferTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterIssueTransferTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_issue_transfer",
 		Description: "Transfer issue to another repository",
+		InputSchema: toolkit.InputSchema[IssueTransferArgs](issueTransferInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Transfer Issue",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args IssueTransferArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh issue transfer", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh issue transfer", nil, err)), nil, nil
+		}
+
 		cmd := []string{"issue", "transfer"}
 
 		// Add positional argument: number
@@ -674,16 +1161,23 @@ func RegisterIssueTransferTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_issue_transfer", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh issue transfer failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh issue transfer", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -692,14 +1186,39 @@ type IssueUnlockArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Issue number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// issueUnlockInputSpec holds the argument constraints for gh issue unlock
+var issueUnlockInputSpec = toolkit.InputSpec{}
+
 // RegisterIssueUnlockTool registers the gh issue unlock tool
-func RegisterIssueUnlockTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterIssueUnlockTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_issue_unlock",
 		Description: "Unlock issue conversation",
+		InputSchema: toolkit.InputSchema[IssueUnlockArgs](issueUnlockInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Unlock Issue",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args IssueUnlockArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh issue unlock", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh issue unlock", nil, err)), nil, nil
+		}
+
 		cmd := []string{"issue", "unlock"}
 
 		// Add positional argument: number
@@ -711,16 +1230,23 @@ func RegisterIssueUnlockTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_issue_unlock", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh issue unlock failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh issue unlock", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -729,14 +1255,39 @@ type IssueUnpinArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Issue number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// issueUnpinInputSpec holds the argument constraints for gh issue unpin
+var issueUnpinInputSpec = toolkit.InputSpec{}
+
 // RegisterIssueUnpinTool registers the gh issue unpin tool
-func RegisterIssueUnpinTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterIssueUnpinTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_issue_unpin",
 		Description: "Unpin an issue from a repository",
+		InputSchema: toolkit.InputSchema[IssueUnpinArgs](issueUnpinInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Unpin Issue",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args IssueUnpinArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh issue unpin", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh issue unpin", nil, err)), nil, nil
+		}
+
 		cmd := []string{"issue", "unpin"}
 
 		// Add positional argument: number
@@ -748,15 +1299,40 @@ func RegisterIssueUnpinTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_issue_unpin", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh issue unpin failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh issue unpin", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterIssueTools registers the gh issue tools
+func RegisterIssueTools(server *mcp.Server, exec executor.Runner) {
+	RegisterIssueCreateTool(server, exec)
+	RegisterIssueListTool(server, exec)
+	RegisterIssueViewTool(server, exec)
+	RegisterIssueCloseTool(server, exec)
+	RegisterIssueCommentTool(server, exec)
+	RegisterIssueDeleteTool(server, exec)
+	RegisterIssueEditTool(server, exec)
+	RegisterIssueLockTool(server, exec)
+	RegisterIssuePinTool(server, exec)
+	RegisterIssueReopenTool(server, exec)
+	RegisterIssueStatusTool(server, exec)
+	RegisterIssueTransferTool(server, exec)
+	RegisterIssueUnlockTool(server, exec)
+	RegisterIssueUnpinTool(server, exec)
+}
@@ -0,0 +1,25 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestIssueArgv calls every gh issue tool with representative
+// arguments and compares the commands they run with testdata/issue.golden
+func TestIssueArgv(t *testing.T) {
+	checkArgvGolden(t, "issue", []argvCase{
+		{Tool: "gh_issue_create", Args: map[string]any{"title": "sample-title", "body": "sample-body", "body_file": "sample-body-file", "assignee": []any{"sample-assignee"}, "label": []any{"sample-label"}, "milestone": "sample-milestone", "project": []any{"sample-project"}, "template": "sample-template", "web": true, "recover": "sample-recover", "repo": "sample-repo"}},
+		{Tool: "gh_issue_list", Args: map[string]any{"assignee": "sample-assignee", "author": "sample-author", "label": []any{"sample-label"}, "mention": "sample-mention", "milestone": "sample-milestone", "state": "open", "search": "sample-search", "app": "sample-app", "limit": 1, "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "web": true, "repo": "sample-repo", "format": "json"}},
+		{Tool: "gh_issue_view", Args: map[string]any{"number": "sample-number", "comments": true, "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "web": true, "repo": "sample-repo", "format": "json"}},
+		{Tool: "gh_issue_close", Args: map[string]any{"number": "sample-number", "comment": "sample-comment", "reason": "completed", "repo": "sample-repo"}},
+		{Tool: "gh_issue_comment", Args: map[string]any{"number": "sample-number", "body": "sample-body", "body_file": "sample-body-file", "editor": true, "web": true, "repo": "sample-repo"}},
+		{Tool: "gh_issue_delete", Args: map[string]any{"number": "sample-number", "yes": true, "repo": "sample-repo"}},
+		{Tool: "gh_issue_edit", Args: map[string]any{"number": "sample-number", "title": "sample-title", "body": "sample-body", "body_file": "sample-body-file", "add_assignee": []any{"sample-add-assignee"}, "remove_assignee": []any{"sample-remove-assignee"}, "add_label": []any{"sample-add-label"}, "remove_label": []any{"sample-remove-label"}, "add_project": []any{"sample-add-project"}, "remove_project": []any{"sample-remove-project"}, "milestone": "sample-milestone", "repo": "sample-repo"}},
+		{Tool: "gh_issue_lock", Args: map[string]any{"number": "sample-number", "reason": "off-topic", "repo": "sample-repo"}},
+		{Tool: "gh_issue_pin", Args: map[string]any{"number": "sample-number", "repo": "sample-repo"}},
+		{Tool: "gh_issue_reopen", Args: map[string]any{"number": "sample-number", "comment": "sample-comment", "repo": "sample-repo"}},
+		{Tool: "gh_issue_status", Args: map[string]any{"jq": "sample-jq", "json": []any{"sample-json"}, "template": "sample-template", "repo": "sample-repo"}},
+		{Tool: "gh_issue_transfer", Args: map[string]any{"number": "sample-number", "destination": "sample-destination", "repo": "sample-repo"}},
+		{Tool: "gh_issue_unlock", Args: map[string]any{"number": "sample-number", "repo": "sample-repo"}},
+		{Tool: "gh_issue_unpin", Args: map[string]any{"number": "sample-number", "repo": "sample-repo"}},
+	})
+}
@@ -2,9 +2,12 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -16,14 +19,44 @@ type LabelCreateArgs struct {
 	Repo        string `json:"repo,omitempty" jsonschema:"Select repository in OWNER/REPO format"`
 
 	Name string `json:"name,omitempty" jsonschema:"Name of the label (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// labelCreateInputSpec holds the argument constraints for gh label create
+var labelCreateInputSpec = toolkit.InputSpec{
+	Required: []string{"name"},
 }
 
 // RegisterLabelCreateTool registers the gh label create tool
-func RegisterLabelCreateTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterLabelCreateTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_label_create",
 		Description: "Create a new label",
+		InputSchema: toolkit.InputSchema[LabelCreateArgs](labelCreateInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Create Label",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args LabelCreateArgs) (*mcp.CallToolResult, any, error) {
+		if err := labelCreateInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh label create", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh label create", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh label create", nil, err)), nil, nil
+		}
+
 		cmd := []string{"label", "create"}
 
 		// Add positional argument: name
@@ -47,35 +80,95 @@ func RegisterLabelCreateTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_label_create", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh label create failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh label create", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
 // LabelListArgs defines parameters for gh label list
 type LabelListArgs struct {
 	Limit    int      `json:"limit,omitempty" jsonschema:"Maximum number of labels to fetch"`
-	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: name,color,description)"`
 	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Web      bool     `json:"web,omitempty" jsonschema:"Open labels in the web browser"`
 	Repo     string   `json:"repo,omitempty" jsonschema:"Select repository in OWNER/REPO format"`
+	Format   string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// labelListInputSpec holds the argument constraints for gh label list
+var labelListInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
+}
+
+// labelListOutputSpec describes the --json output of gh label list
+var labelListOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "color", Type: "string", Description: "Label color"},
+		{Name: "createdAt", Type: "string", Description: "When the label was created"},
+		{Name: "description", Type: "string", Description: "Label description"},
+		{Name: "id", Type: "string", Description: "Node ID"},
+		{Name: "isDefault", Type: "boolean", Description: "Whether the label is a default label"},
+		{Name: "name", Type: "string", Description: "Label name"},
+		{Name: "updatedAt", Type: "string", Description: "When the label was last updated"},
+		{Name: "url", Type: "string", Description: "Label URL"},
+	},
 }
 
+// labelListDefaultJSONFields are requested when no json fields are given
+var labelListDefaultJSONFields = []string{"name", "color", "description"}
+
 // RegisterLabelListTool registers the gh label list tool
-func RegisterLabelListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterLabelListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_label_list",
-		Description: "List labels in a repository",
+		Name:         "gh_label_list",
+		Description:  "List labels in a repository",
+		InputSchema:  toolkit.InputSchema[LabelListArgs](labelListInputSpec),
+		OutputSchema: toolkit.OutputSchema(labelListOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Labels",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args LabelListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh label list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh label list", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" && !args.Web {
+			args.Json = labelListDefaultJSONFields
+		}
+
 		cmd := []string{"label", "list"}
 
 		if args.Limit > 0 {
@@ -102,16 +195,23 @@ func RegisterLabelListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_label_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 30),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh label list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh label list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -123,14 +223,44 @@ type LabelEditArgs struct {
 	Repo        string `json:"repo,omitempty" jsonschema:"Select repository in OWNER/REPO format"`
 
 	Name string `json:"name,omitempty" jsonschema:"Current name of the label (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// labelEditInputSpec holds the argument constraints for gh label edit
+var labelEditInputSpec = toolkit.InputSpec{
+	Required: []string{"name"},
 }
 
 // RegisterLabelEditTool registers the gh label edit tool
-func RegisterLabelEditTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterLabelEditTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_label_edit",
 		Description: "Edit a label",
+		InputSchema: toolkit.InputSchema[LabelEditArgs](labelEditInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Edit Label",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args LabelEditArgs) (*mcp.CallToolResult, any, error) {
+		if err := labelEditInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh label edit", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh label edit", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh label edit", nil, err)), nil, nil
+		}
+
 		cmd := []string{"label", "edit"}
 
 		// Add positional argument: name
@@ -154,16 +284,23 @@ func RegisterLabelEditTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_label_edit", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh label edit failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh label edit", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -173,14 +310,45 @@ type LabelDeleteArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select repository in OWNER/REPO format"`
 
 	Name string `json:"name,omitempty" jsonschema:"Name of the label (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// labelDeleteInputSpec holds the argument constraints for gh label delete
+var labelDeleteInputSpec = toolkit.InputSpec{
+	Required: []string{"name"},
 }
 
 // RegisterLabelDeleteTool registers the gh label delete tool
-func RegisterLabelDeleteTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterLabelDeleteTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_label_delete",
 		Description: "Delete a label from a repository",
+		InputSchema: toolkit.InputSchema[LabelDeleteArgs](labelDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete Label",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args LabelDeleteArgs) (*mcp.CallToolResult, any, error) {
+		if err := labelDeleteInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh label delete", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh label delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh label delete", nil, err)), nil, nil
+		}
+
 		cmd := []string{"label", "delete"}
 
 		// Add positional argument: name
@@ -196,16 +364,29 @@ func RegisterLabelDeleteTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh label delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_label_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh label delete failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh label delete", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -215,14 +396,44 @@ type LabelCloneArgs struct {
 	Repo  string `json:"repo,omitempty" jsonschema:"Destination repository in OWNER/REPO format"`
 
 	SourceRepository string `json:"source_repository,omitempty" jsonschema:"Source repository in OWNER/REPO format (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// labelCloneInputSpec holds the argument constraints for gh label clone
+var labelCloneInputSpec = toolkit.InputSpec{
+	Required: []string{"source_repository"},
 }
 
 // RegisterLabelCloneTool registers the gh label clone tool
-func RegisterLabelCloneTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterLabelCloneTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_label_clone",
 		Description: "Clone labels from one repository to another",
+		InputSchema: toolkit.InputSchema[LabelCloneArgs](labelCloneInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Clone Labels",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args LabelCloneArgs) (*mcp.CallToolResult, any, error) {
+		if err := labelCloneInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh label clone", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh label clone", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh label clone", nil, err)), nil, nil
+		}
+
 		cmd := []string{"label", "clone"}
 
 		// Add positional argument: source_repository
@@ -238,15 +449,31 @@ func RegisterLabelCloneTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_label_clone", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh label clone failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh label clone", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterLabelTools registers the gh label tools
+func RegisterLabelTools(server *mcp.Server, exec executor.Runner) {
+	RegisterLabelCreateTool(server, exec)
+	RegisterLabelListTool(server, exec)
+	RegisterLabelEditTool(server, exec)
+	RegisterLabelDeleteTool(server, exec)
+	RegisterLabelCloneTool(server, exec)
+}
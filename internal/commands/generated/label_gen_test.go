@@ -0,0 +1,16 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestLabelArgv calls every gh label tool with representative
+// arguments and compares the commands they run with testdata/label.golden
+func TestLabelArgv(t *testing.T) {
+	checkArgvGolden(t, "label", []argvCase{
+		{Tool: "gh_label_create", Args: map[string]any{"name": "sample-name", "color": "sample-color", "description": "sample-description", "force": true, "repo": "sample-repo"}},
+		{Tool: "gh_label_list", Args: map[string]any{"limit": 1, "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "web": true, "repo": "sample-repo", "format": "json"}},
+		{Tool: "gh_label_edit", Args: map[string]any{"name": "sample-name", "color": "sample-color", "description": "sample-description", "new_name": "sample-new-name", "repo": "sample-repo"}},
+		{Tool: "gh_label_delete", Args: map[string]any{"name": "sample-name", "yes": true, "repo": "sample-repo"}},
+		{Tool: "gh_label_clone", Args: map[string]any{"source_repository": "sample-source-repository", "force": true, "repo": "sample-repo"}},
+	})
+}
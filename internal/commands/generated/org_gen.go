@@ -2,9 +2,11 @@
 package generated
 
 import (
+	"cmp"
 	"context"
-	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -13,14 +15,39 @@ type OrgListArgs struct {
 	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
 	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// orgListInputSpec holds the argument constraints for gh org list
+var orgListInputSpec = toolkit.InputSpec{}
+
 // RegisterOrgListTool registers the gh org list tool
-func RegisterOrgListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterOrgListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_org_list",
 		Description: "List organizations for the authenticated user",
+		InputSchema: toolkit.InputSchema[OrgListArgs](orgListInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Organizations",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args OrgListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh org list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh org list", nil, err)), nil, nil
+		}
+
 		cmd := []string{"org", "list"}
 
 		for _, v := range args.Json {
@@ -35,15 +62,27 @@ func RegisterOrgListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--template", args.Template)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_org_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh org list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh org list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterOrgTools registers the gh org tools
+func RegisterOrgTools(server *mcp.Server, exec executor.Runner) {
+	RegisterOrgListTool(server, exec)
+}
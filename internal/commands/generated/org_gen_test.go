@@ -0,0 +1,12 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestOrgArgv calls every gh org tool with representative
+// arguments and compares the commands they run with testdata/org.golden
+func TestOrgArgv(t *testing.T) {
+	checkArgvGolden(t, "org", []argvCase{
+		{Tool: "gh_org_list", Args: map[string]any{"json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template"}},
+	})
+}
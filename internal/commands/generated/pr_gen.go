@@ -2,9 +2,12 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -30,14 +33,39 @@ type PrCreateArgs struct {
 	Web              bool     `json:"web,omitempty" jsonschema:"Open the web browser to create a pull request"`
 	DryRun           bool     `json:"dry_run,omitempty" jsonschema:"Print details instead of creating the PR"`
 	Repo             string   `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// prCreateInputSpec holds the argument constraints for gh pr create
+var prCreateInputSpec = toolkit.InputSpec{}
+
 // RegisterPrCreateTool registers the gh pr create tool
-func RegisterPrCreateTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterPrCreateTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_pr_create",
 		Description: "Create a pull request on GitHub",
+		InputSchema: toolkit.InputSchema[PrCreateArgs](prCreateInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Create Pull Request",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args PrCreateArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh pr create", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh pr create", nil, err)), nil, nil
+		}
+
 		cmd := []string{"pr", "create"}
 
 		if args.Title != "" {
@@ -120,16 +148,23 @@ func RegisterPrCreateTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_pr_create", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh pr create failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh pr create", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -145,19 +180,111 @@ type PrListArgs struct {
 	App      string   `json:"app,omitempty" jsonschema:"Filter by GitHub App author"`
 	Draft    bool     `json:"draft,omitempty" jsonschema:"Filter by draft state"`
 	Limit    int      `json:"limit,omitempty" jsonschema:"Maximum number of items to fetch"`
-	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: number,title,state,author,headRefName,baseRefName,isDraft,labels,createdAt,updatedAt,url)"`
 	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Web      bool     `json:"web,omitempty" jsonschema:"List pull requests in the web browser"`
 	Repo     string   `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
+	Format   string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// prListInputSpec holds the argument constraints for gh pr list
+var prListInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"state":  {Enum: []string{"open", "closed", "merged", "all"}, Default: "open"},
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
+}
+
+// prListOutputSpec describes the --json output of gh pr list
+var prListOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "additions", Type: "integer", Description: "Lines added"},
+		{Name: "assignees", Type: "array", Description: "Assigned users"},
+		{Name: "author", Type: "object", Description: "Pull request author"},
+		{Name: "autoMergeRequest", Type: "object", Description: "Auto-merge settings", Nullable: true},
+		{Name: "baseRefName", Type: "string", Description: "Base branch name"},
+		{Name: "baseRefOid", Type: "string", Description: "Base branch commit SHA"},
+		{Name: "body", Type: "string", Description: "Pull request body"},
+		{Name: "changedFiles", Type: "integer", Description: "Number of changed files"},
+		{Name: "closed", Type: "boolean", Description: "Whether the pull request is closed"},
+		{Name: "closedAt", Type: "string", Description: "When the pull request was closed", Nullable: true},
+		{Name: "closingIssuesReferences", Type: "array", Description: "Issues closed by the pull request"},
+		{Name: "comments", Type: "array", Description: "Pull request comments"},
+		{Name: "commits", Type: "array", Description: "Pull request commits"},
+		{Name: "createdAt", Type: "string", Description: "When the pull request was created"},
+		{Name: "deletions", Type: "integer", Description: "Lines removed"},
+		{Name: "files", Type: "array", Description: "Changed files"},
+		{Name: "fullDatabaseId", Type: "string", Description: "Database ID"},
+		{Name: "headRefName", Type: "string", Description: "Head branch name"},
+		{Name: "headRefOid", Type: "string", Description: "Head branch commit SHA"},
+		{Name: "headRepository", Type: "object", Description: "Head repository", Nullable: true},
+		{Name: "headRepositoryOwner", Type: "object", Description: "Owner of the head repository"},
+		{Name: "id", Type: "string", Description: "Node ID"},
+		{Name: "isCrossRepository", Type: "boolean", Description: "Whether the head is in a fork"},
+		{Name: "isDraft", Type: "boolean", Description: "Whether the pull request is a draft"},
+		{Name: "labels", Type: "array", Description: "Applied labels"},
+		{Name: "latestReviews", Type: "array", Description: "Latest review by each reviewer"},
+		{Name: "maintainerCanModify", Type: "boolean", Description: "Whether maintainers can push to the head branch"},
+		{Name: "mergeCommit", Type: "object", Description: "Merge commit", Nullable: true},
+		{Name: "mergeStateStatus", Type: "string", Description: "Merge state status"},
+		{Name: "mergeable", Type: "string", Description: "Whether the pull request can be merged"},
+		{Name: "mergedAt", Type: "string", Description: "When the pull request was merged", Nullable: true},
+		{Name: "mergedBy", Type: "object", Description: "User who merged the pull request", Nullable: true},
+		{Name: "milestone", Type: "object", Description: "Milestone", Nullable: true},
+		{Name: "number", Type: "integer", Description: "Pull request number"},
+		{Name: "potentialMergeCommit", Type: "object", Description: "Commit that would be created on merge", Nullable: true},
+		{Name: "projectCards", Type: "array", Description: "Classic project cards"},
+		{Name: "projectItems", Type: "array", Description: "Project items"},
+		{Name: "reactionGroups", Type: "array", Description: "Reactions grouped by content"},
+		{Name: "reviewDecision", Type: "string", Description: "Review decision"},
+		{Name: "reviewRequests", Type: "array", Description: "Requested reviewers"},
+		{Name: "reviews", Type: "array", Description: "Reviews"},
+		{Name: "state", Type: "string", Description: "Pull request state"},
+		{Name: "statusCheckRollup", Type: "array", Description: "Status checks for the head commit"},
+		{Name: "title", Type: "string", Description: "Pull request title"},
+		{Name: "updatedAt", Type: "string", Description: "When the pull request was last updated"},
+		{Name: "url", Type: "string", Description: "Pull request URL"},
+	},
 }
 
+// prListDefaultJSONFields are requested when no json fields are given
+var prListDefaultJSONFields = []string{"number", "title", "state", "author", "headRefName", "baseRefName", "isDraft", "labels", "createdAt", "updatedAt", "url"}
+
 // RegisterPrListTool registers the gh pr list tool
-func RegisterPrListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterPrListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_pr_list",
-		Description: "List pull requests in a repository",
+		Name:         "gh_pr_list",
+		Description:  "List pull requests in a repository",
+		InputSchema:  toolkit.InputSchema[PrListArgs](prListInputSpec),
+		OutputSchema: toolkit.OutputSchema(prListOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Pull Requests",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args PrListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh pr list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh pr list", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" && !args.Web {
+			args.Json = prListDefaultJSONFields
+		}
+
 		cmd := []string{"pr", "list"}
 
 		if args.Assignee != "" {
@@ -220,37 +347,134 @@ func RegisterPrListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_pr_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh pr list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh pr list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
 // PrViewArgs defines parameters for gh pr view
 type PrViewArgs struct {
 	Comments bool     `json:"comments,omitempty" jsonschema:"View pull request comments"`
-	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: number,title,state,author,body,headRefName,baseRefName,isDraft,mergeable,reviewDecision,labels,assignees,createdAt,updatedAt,url)"`
 	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Web      bool     `json:"web,omitempty" jsonschema:"Open pull request in the browser"`
 	Repo     string   `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
+	Format   string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
 
 	Number string `json:"number,omitempty" jsonschema:"Pull request number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// prViewInputSpec holds the argument constraints for gh pr view
+var prViewInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
+}
+
+// prViewOutputSpec describes the --json output of gh pr view
+var prViewOutputSpec = toolkit.OutputSpec{
+	Fields: []toolkit.OutputField{
+		{Name: "additions", Type: "integer", Description: "Lines added"},
+		{Name: "assignees", Type: "array", Description: "Assigned users"},
+		{Name: "author", Type: "object", Description: "Pull request author"},
+		{Name: "autoMergeRequest", Type: "object", Description: "Auto-merge settings", Nullable: true},
+		{Name: "baseRefName", Type: "string", Description: "Base branch name"},
+		{Name: "baseRefOid", Type: "string", Description: "Base branch commit SHA"},
+		{Name: "body", Type: "string", Description: "Pull request body"},
+		{Name: "changedFiles", Type: "integer", Description: "Number of changed files"},
+		{Name: "closed", Type: "boolean", Description: "Whether the pull request is closed"},
+		{Name: "closedAt", Type: "string", Description: "When the pull request was closed", Nullable: true},
+		{Name: "closingIssuesReferences", Type: "array", Description: "Issues closed by the pull request"},
+		{Name: "comments", Type: "array", Description: "Pull request comments"},
+		{Name: "commits", Type: "array", Description: "Pull request commits"},
+		{Name: "createdAt", Type: "string", Description: "When the pull request was created"},
+		{Name: "deletions", Type: "integer", Description: "Lines removed"},
+		{Name: "files", Type: "array", Description: "Changed files"},
+		{Name: "fullDatabaseId", Type: "string", Description: "Database ID"},
+		{Name: "headRefName", Type: "string", Description: "Head branch name"},
+		{Name: "headRefOid", Type: "string", Description: "Head branch commit SHA"},
+		{Name: "headRepository", Type: "object", Description: "Head repository", Nullable: true},
+		{Name: "headRepositoryOwner", Type: "object", Description: "Owner of the head repository"},
+		{Name: "id", Type: "string", Description: "Node ID"},
+		{Name: "isCrossRepository", Type: "boolean", Description: "Whether the head is in a fork"},
+		{Name: "isDraft", Type: "boolean", Description: "Whether the pull request is a draft"},
+		{Name: "labels", Type: "array", Description: "Applied labels"},
+		{Name: "latestReviews", Type: "array", Description: "Latest review by each reviewer"},
+		{Name: "maintainerCanModify", Type: "boolean", Description: "Whether maintainers can push to the head branch"},
+		{Name: "mergeCommit", Type: "object", Description: "Merge commit", Nullable: true},
+		{Name: "mergeStateStatus", Type: "string", Description: "Merge state status"},
+		{Name: "mergeable", Type: "string", Description: "Whether the pull request can be merged"},
+		{Name: "mergedAt", Type: "string", Description: "When the pull request was merged", Nullable: true},
+		{Name: "mergedBy", Type: "object", Description: "User who merged the pull request", Nullable: true},
+		{Name: "milestone", Type: "object", Description: "Milestone", Nullable: true},
+		{Name: "number", Type: "integer", Description: "Pull request number"},
+		{Name: "potentialMergeCommit", Type: "object", Description: "Commit that would be created on merge", Nullable: true},
+		{Name: "projectCards", Type: "array", Description: "Classic project cards"},
+		{Name: "projectItems", Type: "array", Description: "Project items"},
+		{Name: "reactionGroups", Type: "array", Description: "Reactions grouped by content"},
+		{Name: "reviewDecision", Type: "string", Description: "Review decision"},
+		{Name: "reviewRequests", Type: "array", Description: "Requested reviewers"},
+		{Name: "reviews", Type: "array", Description: "Reviews"},
+		{Name: "state", Type: "string", Description: "Pull request state"},
+		{Name: "statusCheckRollup", Type: "array", Description: "Status checks for the head commit"},
+		{Name: "title", Type: "string", Description: "Pull request title"},
+		{Name: "updatedAt", Type: "string", Description: "When the pull request was last updated"},
+		{Name: "url", Type: "string", Description: "Pull request URL"},
+	},
 }
 
+// prViewDefaultJSONFields are requested when no json fields are given
+var prViewDefaultJSONFields = []string{"number", "title", "state", "author", "body", "headRefName", "baseRefName", "isDraft", "mergeable", "reviewDecision", "labels", "assignees", "createdAt", "updatedAt", "url"}
+
 // RegisterPrViewTool registers the gh pr view tool
-func RegisterPrViewTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterPrViewTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_pr_view",
-		Description: "View a pull request",
+		Name:         "gh_pr_view",
+		Description:  "View a pull request",
+		InputSchema:  toolkit.InputSchema[PrViewArgs](prViewInputSpec),
+		OutputSchema: toolkit.OutputSchema(prViewOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "View Pull Request",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args PrViewArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh pr view", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh pr view", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" && !args.Web && !args.Comments {
+			args.Json = prViewDefaultJSONFields
+		}
+
 		cmd := []string{"pr", "view"}
 
 		// Add positional argument: number
@@ -282,16 +506,23 @@ func RegisterPrViewTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_pr_view", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh pr view failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh pr view", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, false)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -302,14 +533,39 @@ type PrCloseArgs struct {
 	Repo         string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Pull request number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// prCloseInputSpec holds the argument constraints for gh pr close
+var prCloseInputSpec = toolkit.InputSpec{}
+
 // RegisterPrCloseTool registers the gh pr close tool
-func RegisterPrCloseTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterPrCloseTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_pr_close",
 		Description: "Close a pull request",
+		InputSchema: toolkit.InputSchema[PrCloseArgs](prCloseInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Close Pull Request",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args PrCloseArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh pr close", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh pr close", nil, err)), nil, nil
+		}
+
 		cmd := []string{"pr", "close"}
 
 		// Add positional argument: number
@@ -329,16 +585,23 @@ func RegisterPrCloseTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_pr_close", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh pr close failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh pr close", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -358,14 +621,39 @@ type PrMergeArgs struct {
 	Repo            string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Pull request number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// prMergeInputSpec holds the argument constraints for gh pr merge
+var prMergeInputSpec = toolkit.InputSpec{}
+
 // RegisterPrMergeTool registers the gh pr merge tool
-func RegisterPrMergeTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterPrMergeTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_pr_merge",
 		Description: "Merge a pull request",
+		InputSchema: toolkit.InputSchema[PrMergeArgs](prMergeInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Merge Pull Request",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args PrMergeArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh pr merge", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh pr merge", nil, err)), nil, nil
+		}
+
 		cmd := []string{"pr", "merge"}
 
 		// Add positional argument: number
@@ -421,16 +709,23 @@ func RegisterPrMergeTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_pr_merge", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh pr merge failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh pr merge", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -443,14 +738,39 @@ type PrCheckoutArgs struct {
 	Repo              string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Pull request number or URL or branch (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// prCheckoutInputSpec holds the argument constraints for gh pr checkout
+var prCheckoutInputSpec = toolkit.InputSpec{}
+
 // RegisterPrCheckoutTool registers the gh pr checkout tool
-func RegisterPrCheckoutTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterPrCheckoutTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_pr_checkout",
 		Description: "Check out a pull request in git",
+		InputSchema: toolkit.InputSchema[PrCheckoutArgs](prCheckoutInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Check Out Pull Request",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args PrCheckoutArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh pr checkout", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh pr checkout", nil, err)), nil, nil
+		}
+
 		cmd := []string{"pr", "checkout"}
 
 		// Add positional argument: number
@@ -478,16 +798,23 @@ func RegisterPrCheckoutTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_pr_checkout", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh pr checkout failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh pr checkout", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -500,14 +827,39 @@ type PrChecksArgs struct {
 	Repo     string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Pull request number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// prChecksInputSpec holds the argument constraints for gh pr checks
+var prChecksInputSpec = toolkit.InputSpec{}
+
 // RegisterPrChecksTool registers the gh pr checks tool
-func RegisterPrChecksTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterPrChecksTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_pr_checks",
 		Description: "Show CI status for a pull request",
+		InputSchema: toolkit.InputSchema[PrChecksArgs](prChecksInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Pull Request Checks",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args PrChecksArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh pr checks", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh pr checks", nil, err)), nil, nil
+		}
+
 		cmd := []string{"pr", "checks"}
 
 		// Add positional argument: number
@@ -535,16 +887,24 @@ func RegisterPrChecksTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_pr_checks", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 3600),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+			OnLine:      toolkit.Progress(ctx, req),
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh pr checks failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh pr checks", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -557,14 +917,43 @@ type PrDiffArgs struct {
 	Repo     string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Pull request number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// prDiffInputSpec holds the argument constraints for gh pr diff
+var prDiffInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"color": {Enum: []string{"always", "never", "auto"}},
+	},
 }
 
 // RegisterPrDiffTool registers the gh pr diff tool
-func RegisterPrDiffTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterPrDiffTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_pr_diff",
 		Description: "View changes in a pull request",
+		InputSchema: toolkit.InputSchema[PrDiffArgs](prDiffInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Pull Request Diff",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args PrDiffArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh pr diff", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh pr diff", nil, err)), nil, nil
+		}
+
 		cmd := []string{"pr", "diff"}
 
 		// Add positional argument: number
@@ -592,16 +981,23 @@ func RegisterPrDiffTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_pr_diff", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh pr diff failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh pr diff", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -614,14 +1010,39 @@ type PrCommentArgs struct {
 	Repo     string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Pull request number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// prCommentInputSpec holds the argument constraints for gh pr comment
+var prCommentInputSpec = toolkit.InputSpec{}
+
 // RegisterPrCommentTool registers the gh pr comment tool
-func RegisterPrCommentTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterPrCommentTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_pr_comment",
 		Description: "Add a comment to a pull request",
+		InputSchema: toolkit.InputSchema[PrCommentArgs](prCommentInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Comment on Pull Request",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args PrCommentArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh pr comment", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh pr comment", nil, err)), nil, nil
+		}
+
 		cmd := []string{"pr", "comment"}
 
 		// Add positional argument: number
@@ -649,16 +1070,23 @@ func RegisterPrCommentTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_pr_comment", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh pr comment failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh pr comment", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -680,14 +1108,39 @@ type PrEditArgs struct {
 	Repo           string   `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Pull request number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// prEditInputSpec holds the argument constraints for gh pr edit
+var prEditInputSpec = toolkit.InputSpec{}
+
 // RegisterPrEditTool registers the gh pr edit tool
-func RegisterPrEditTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterPrEditTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_pr_edit",
 		Description: "Edit a pull request",
+		InputSchema: toolkit.InputSchema[PrEditArgs](prEditInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Edit Pull Request",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args PrEditArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh pr edit", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh pr edit", nil, err)), nil, nil
+		}
+
 		cmd := []string{"pr", "edit"}
 
 		// Add positional argument: number
@@ -751,16 +1204,23 @@ func RegisterPrEditTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_pr_edit", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh pr edit failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh pr edit", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -770,14 +1230,39 @@ type PrReadyArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Pull request number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// prReadyInputSpec holds the argument constraints for gh pr ready
+var prReadyInputSpec = toolkit.InputSpec{}
+
 // RegisterPrReadyTool registers the gh pr ready tool
-func RegisterPrReadyTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterPrReadyTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_pr_ready",
 		Description: "Mark a pull request as ready for review",
+		InputSchema: toolkit.InputSchema[PrReadyArgs](prReadyInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Mark Pull Request Ready",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args PrReadyArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh pr ready", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh pr ready", nil, err)), nil, nil
+		}
+
 		cmd := []string{"pr", "ready"}
 
 		// Add positional argument: number
@@ -793,16 +1278,23 @@ func RegisterPrReadyTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_pr_ready", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh pr ready failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh pr ready", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -812,14 +1304,39 @@ type PrReopenArgs struct {
 	Repo    string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Pull request number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// prReopenInputSpec holds the argument constraints for gh pr reopen
+var prReopenInputSpec = toolkit.InputSpec{}
+
 // RegisterPrReopenTool registers the gh pr reopen tool
-func RegisterPrReopenTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterPrReopenTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_pr_reopen",
 		Description: "Reopen a closed pull request",
+		InputSchema: toolkit.InputSchema[PrReopenArgs](prReopenInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Reopen Pull Request",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args PrReopenArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh pr reopen", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh pr reopen", nil, err)), nil, nil
+		}
+
 		cmd := []string{"pr", "reopen"}
 
 		// Add positional argument: number
@@ -835,16 +1352,23 @@ func RegisterPrReopenTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_pr_reopen", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh pr reopen failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh pr reopen", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -858,14 +1382,39 @@ type PrReviewArgs struct {
 	Repo           string `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Pull request number or URL (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// prReviewInputSpec holds the argument constraints for gh pr review
+var prReviewInputSpec = toolkit.InputSpec{}
+
 // RegisterPrReviewTool registers the gh pr review tool
-func RegisterPrReviewTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterPrReviewTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_pr_review",
 		Description: "Add a review to a pull request",
+		InputSchema: toolkit.InputSchema[PrReviewArgs](prReviewInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Review Pull Request",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args PrReviewArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh pr review", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh pr review", nil, err)), nil, nil
+		}
+
 		cmd := []string{"pr", "review"}
 
 		// Add positional argument: number
@@ -897,16 +1446,23 @@ func RegisterPrReviewTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_pr_review", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh pr review failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh pr review", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -916,14 +1472,49 @@ type PrStatusArgs struct {
 	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Repo     string   `json:"repo,omitempty" jsonschema:"Select target repository in OWNER/REPO format"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// prStatusInputSpec holds the argument constraints for gh pr status
+var prStatusInputSpec = toolkit.InputSpec{}
+
+// prStatusOutputSpec describes the --json output of gh pr status
+var prStatusOutputSpec = toolkit.OutputSpec{
+	Fields: []toolkit.OutputField{
+		{Name: "createdBy", Type: "array", Description: "Pull requests opened by you"},
+		{Name: "currentBranch", Type: "object", Description: "Pull request for the current branch", Nullable: true},
+		{Name: "needsReview", Type: "array", Description: "Pull requests requesting your review"},
+	},
 }
 
 // RegisterPrStatusTool registers the gh pr status tool
-func RegisterPrStatusTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterPrStatusTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_pr_status",
-		Description: "Show status of relevant pull requests",
+		Name:         "gh_pr_status",
+		Description:  "Show status of relevant pull requests",
+		InputSchema:  toolkit.InputSchema[PrStatusArgs](prStatusInputSpec),
+		OutputSchema: toolkit.OutputSchema(prStatusOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Pull Request Status",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args PrStatusArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh pr status", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh pr status", nil, err)), nil, nil
+		}
+
 		cmd := []string{"pr", "status"}
 
 		if args.Jq != "" {
@@ -942,15 +1533,40 @@ func RegisterPrStatusTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_pr_status", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh pr status failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh pr status", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, false)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
+
+// RegisterPrTools registers the gh pr tools
+func RegisterPrTools(server *mcp.Server, exec executor.Runner) {
+	RegisterPrCreateTool(server, exec)
+	RegisterPrListTool(server, exec)
+	RegisterPrViewTool(server, exec)
+	RegisterPrCloseTool(server, exec)
+	RegisterPrMergeTool(server, exec)
+	RegisterPrCheckoutTool(server, exec)
+	RegisterPrChecksTool(server, exec)
+	RegisterPrDiffTool(server, exec)
+	RegisterPrCommentTool(server, exec)
+	RegisterPrEditTool(server, exec)
+	RegisterPrReadyTool(server, exec)
+	RegisterPrReopenTool(server, exec)
+	RegisterPrReviewTool(server, exec)
+	RegisterPrStatusTool(server, exec)
+}
@@ -0,0 +1,25 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestPrArgv calls every gh pr tool with representative
+// arguments and compares the commands they run with testdata/pr.golden
+func TestPrArgv(t *testing.T) {
+	checkArgvGolden(t, "pr", []argvCase{
+		{Tool: "gh_pr_create", Args: map[string]any{"title": "sample-title", "body": "sample-body", "body_file": "sample-body-file", "fill": true, "fill_first": true, "fill_verbose": true, "base": "sample-base", "head": "sample-head", "draft": true, "no_maintainer_edit": true, "assignee": []any{"sample-assignee"}, "reviewer": []any{"sample-reviewer"}, "label": []any{"sample-label"}, "milestone": "sample-milestone", "project": []any{"sample-project"}, "template": "sample-template", "recover": "sample-recover", "web": true, "dry_run": true, "repo": "sample-repo"}},
+		{Tool: "gh_pr_list", Args: map[string]any{"assignee": "sample-assignee", "author": "sample-author", "base": "sample-base", "head": "sample-head", "label": []any{"sample-label"}, "state": "open", "search": "sample-search", "app": "sample-app", "draft": true, "limit": 1, "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "web": true, "repo": "sample-repo", "format": "json"}},
+		{Tool: "gh_pr_view", Args: map[string]any{"number": "sample-number", "comments": true, "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "web": true, "repo": "sample-repo", "format": "json"}},
+		{Tool: "gh_pr_close", Args: map[string]any{"number": "sample-number", "comment": "sample-comment", "delete_branch": true, "repo": "sample-repo"}},
+		{Tool: "gh_pr_merge", Args: map[string]any{"number": "sample-number", "admin": true, "auto": true, "body": "sample-body", "body_file": "sample-body-file", "delete_branch": true, "disable_auto": true, "merge": true, "rebase": true, "squash": true, "subject": "sample-subject", "match_head_commit": "sample-match-head-commit", "repo": "sample-repo"}},
+		{Tool: "gh_pr_checkout", Args: map[string]any{"number": "sample-number", "branch": "sample-branch", "detach": true, "force": true, "recurse_submodules": true, "repo": "sample-repo"}},
+		{Tool: "gh_pr_checks", Args: map[string]any{"number": "sample-number", "fail_fast": true, "interval": 1, "watch": true, "web": true, "repo": "sample-repo"}},
+		{Tool: "gh_pr_diff", Args: map[string]any{"number": "sample-number", "color": "always", "name_only": true, "patch": true, "web": true, "repo": "sample-repo"}},
+		{Tool: "gh_pr_comment", Args: map[string]any{"number": "sample-number", "body": "sample-body", "body_file": "sample-body-file", "editor": true, "web": true, "repo": "sample-repo"}},
+		{Tool: "gh_pr_edit", Args: map[string]any{"number": "sample-number", "title": "sample-title", "body": "sample-body", "body_file": "sample-body-file", "add_assignee": []any{"sample-add-assignee"}, "remove_assignee": []any{"sample-remove-assignee"}, "add_label": []any{"sample-add-label"}, "remove_label": []any{"sample-remove-label"}, "add_project": []any{"sample-add-project"}, "remove_project": []any{"sample-remove-project"}, "add_reviewer": []any{"sample-add-reviewer"}, "remove_reviewer": []any{"sample-remove-reviewer"}, "milestone": "sample-milestone", "base": "sample-base", "repo": "sample-repo"}},
+		{Tool: "gh_pr_ready", Args: map[string]any{"number": "sample-number", "undo": true, "repo": "sample-repo"}},
+		{Tool: "gh_pr_reopen", Args: map[string]any{"number": "sample-number", "comment": "sample-comment", "repo": "sample-repo"}},
+		{Tool: "gh_pr_review", Args: map[string]any{"number": "sample-number", "approve": true, "comment": true, "request_changes": true, "body": "sample-body", "body_file": "sample-body-file", "repo": "sample-repo"}},
+		{Tool: "gh_pr_status", Args: map[string]any{"jq": "sample-jq", "json": []any{"sample-json"}, "template": "sample-template", "repo": "sample-repo"}},
+	})
+}
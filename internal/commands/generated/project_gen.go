@@ -2,9 +2,12 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -15,14 +18,47 @@ type ProjectCreateArgs struct {
 	Format   string `json:"format,omitempty" jsonschema:"Output format"`
 	Jq       string `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template string `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectCreateInputSpec holds the argument constraints for gh project create
+var projectCreateInputSpec = toolkit.InputSpec{
+	Required: []string{"owner", "title"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectCreateTool registers the gh project create tool
-func RegisterProjectCreateTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectCreateTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_create",
 		Description: "Create a project",
+		InputSchema: toolkit.InputSchema[ProjectCreateArgs](projectCreateInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Create Project",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectCreateArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectCreateInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project create", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project create", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project create", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "create"}
 
 		if args.Owner != "" {
@@ -45,16 +81,23 @@ func RegisterProjectCreateTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--template", args.Template)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_create", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project create failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project create", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -67,14 +110,47 @@ type ProjectListArgs struct {
 	Jq       string `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template string `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Web      bool   `json:"web,omitempty" jsonschema:"Open projects list in the browser"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectListInputSpec holds the argument constraints for gh project list
+var projectListInputSpec = toolkit.InputSpec{
+	Required: []string{"owner"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectListTool registers the gh project list tool
-func RegisterProjectListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_list",
 		Description: "List the projects for an owner",
+		InputSchema: toolkit.InputSchema[ProjectListArgs](projectListInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Projects",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectListArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectListInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project list", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project list", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "list"}
 
 		if args.Owner != "" {
@@ -105,16 +181,23 @@ func RegisterProjectListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--web")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -127,14 +210,47 @@ type ProjectViewArgs struct {
 	Web      bool   `json:"web,omitempty" jsonschema:"Open project in the browser"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectViewInputSpec holds the argument constraints for gh project view
+var projectViewInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectViewTool registers the gh project view tool
-func RegisterProjectViewTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectViewTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_view",
 		Description: "View a project",
+		InputSchema: toolkit.InputSchema[ProjectViewArgs](projectViewInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "View Project",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectViewArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectViewInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project view", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project view", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project view", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "view"}
 
 		// Add positional argument: number
@@ -162,16 +278,23 @@ func RegisterProjectViewTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--web")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_view", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project view failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project view", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -187,14 +310,48 @@ type ProjectEditArgs struct {
 	Template    string `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectEditInputSpec holds the argument constraints for gh project edit
+var projectEditInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner"},
+	Properties: map[string]toolkit.Property{
+		"visibility": {Enum: []string{"PUBLIC", "PRIVATE"}},
+		"format":     {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectEditTool registers the gh project edit tool
-func RegisterProjectEditTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectEditTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_edit",
 		Description: "Edit a project",
+		InputSchema: toolkit.InputSchema[ProjectEditArgs](projectEditInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Edit Project",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectEditArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectEditInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project edit", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project edit", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project edit", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "edit"}
 
 		// Add positional argument: number
@@ -234,16 +391,23 @@ func RegisterProjectEditTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--template", args.Template)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_edit", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project edit failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project edit", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -254,14 +418,47 @@ type ProjectCloseArgs struct {
 	Format string `json:"format,omitempty" jsonschema:"Output format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectCloseInputSpec holds the argument constraints for gh project close
+var projectCloseInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectCloseTool registers the gh project close tool
-func RegisterProjectCloseTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectCloseTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_close",
 		Description: "Close a project",
+		InputSchema: toolkit.InputSchema[ProjectCloseArgs](projectCloseInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Close Project",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectCloseArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectCloseInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project close", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project close", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project close", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "close"}
 
 		// Add positional argument: number
@@ -281,16 +478,23 @@ func RegisterProjectCloseTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--format", args.Format)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_close", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project close failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project close", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -300,14 +504,48 @@ type ProjectDeleteArgs struct {
 	Format string `json:"format,omitempty" jsonschema:"Output format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// projectDeleteInputSpec holds the argument constraints for gh project delete
+var projectDeleteInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectDeleteTool registers the gh project delete tool
-func RegisterProjectDeleteTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectDeleteTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_delete",
 		Description: "Delete a project",
+		InputSchema: toolkit.InputSchema[ProjectDeleteArgs](projectDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete Project",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectDeleteArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectDeleteInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project delete", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project delete", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "delete"}
 
 		// Add positional argument: number
@@ -323,16 +561,29 @@ func RegisterProjectDeleteTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--format", args.Format)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh project delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_project_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project delete failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project delete", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -345,14 +596,47 @@ type ProjectCopyArgs struct {
 	Format      string `json:"format,omitempty" jsonschema:"Output format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number to copy (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectCopyInputSpec holds the argument constraints for gh project copy
+var projectCopyInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "source_owner", "target_owner"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectCopyTool registers the gh project copy tool
-func RegisterProjectCopyTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectCopyTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_copy",
 		Description: "Copy a project",
+		InputSchema: toolkit.InputSchema[ProjectCopyArgs](projectCopyInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Copy Project",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectCopyArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectCopyInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project copy", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project copy", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project copy", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "copy"}
 
 		// Add positional argument: number
@@ -380,16 +664,23 @@ func RegisterProjectCopyTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--format", args.Format)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_copy", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project copy failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project copy", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -402,14 +693,47 @@ type ProjectFieldListArgs struct {
 	Template string `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectFieldListInputSpec holds the argument constraints for gh project field-list
+var projectFieldListInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectFieldListTool registers the gh project field-list tool
-func RegisterProjectFieldListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectFieldListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_field_list",
 		Description: "List the fields in a project",
+		InputSchema: toolkit.InputSchema[ProjectFieldListArgs](projectFieldListInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Project Fields",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectFieldListArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectFieldListInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project field-list", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project field-list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project field-list", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "field-list"}
 
 		// Add positional argument: number
@@ -437,16 +761,23 @@ func RegisterProjectFieldListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--template", args.Template)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_field_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project field-list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project field-list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -458,14 +789,48 @@ type ProjectFieldCreateArgs struct {
 	Format   string `json:"format,omitempty" jsonschema:"Output format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectFieldCreateInputSpec holds the argument constraints for gh project field-create
+var projectFieldCreateInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner", "name", "data_type"},
+	Properties: map[string]toolkit.Property{
+		"data_type": {Enum: []string{"TEXT", "NUMBER", "DATE", "SINGLE_SELECT", "ITERATION"}},
+		"format":    {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectFieldCreateTool registers the gh project field-create tool
-func RegisterProjectFieldCreateTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectFieldCreateTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_field_create",
 		Description: "Create a field in a project",
+		InputSchema: toolkit.InputSchema[ProjectFieldCreateArgs](projectFieldCreateInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Create Project Field",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectFieldCreateArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectFieldCreateInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project field-create", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project field-create", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project field-create", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "field-create"}
 
 		// Add positional argument: number
@@ -489,16 +854,23 @@ func RegisterProjectFieldCreateTool(server *mcp.Server, exec *executor.Executor)
 			cmd = append(cmd, "--format", args.Format)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_field_create", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project field-create failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project field-create", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -509,14 +881,48 @@ type ProjectFieldDeleteArgs struct {
 	Format string `json:"format,omitempty" jsonschema:"Output format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// projectFieldDeleteInputSpec holds the argument constraints for gh project field-delete
+var projectFieldDeleteInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner", "id"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectFieldDeleteTool registers the gh project field-delete tool
-func RegisterProjectFieldDeleteTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectFieldDeleteTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_field_delete",
 		Description: "Delete a field in a project",
+		InputSchema: toolkit.InputSchema[ProjectFieldDeleteArgs](projectFieldDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete Project Field",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectFieldDeleteArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectFieldDeleteInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project field-delete", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project field-delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project field-delete", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "field-delete"}
 
 		// Add positional argument: number
@@ -536,16 +942,29 @@ func RegisterProjectFieldDeleteTool(server *mcp.Server, exec *executor.Executor)
 			cmd = append(cmd, "--format", args.Format)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh project field-delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_project_field_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project field-delete failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project field-delete", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -558,14 +977,47 @@ type ProjectItemListArgs struct {
 	Template string `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectItemListInputSpec holds the argument constraints for gh project item-list
+var projectItemListInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectItemListTool registers the gh project item-list tool
-func RegisterProjectItemListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectItemListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_item_list",
 		Description: "List the items in a project",
+		InputSchema: toolkit.InputSchema[ProjectItemListArgs](projectItemListInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Project Items",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectItemListArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectItemListInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project item-list", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project item-list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project item-list", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "item-list"}
 
 		// Add positional argument: number
@@ -593,16 +1045,23 @@ func RegisterProjectItemListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--template", args.Template)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_item_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project item-list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project item-list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -615,14 +1074,47 @@ type ProjectItemAddArgs struct {
 	Template string `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectItemAddInputSpec holds the argument constraints for gh project item-add
+var projectItemAddInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner", "url"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectItemAddTool registers the gh project item-add tool
-func RegisterProjectItemAddTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectItemAddTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_item_add",
 		Description: "Add a pull request or issue to a project",
+		InputSchema: toolkit.InputSchema[ProjectItemAddArgs](projectItemAddInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Add Project Item",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectItemAddArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectItemAddInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project item-add", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project item-add", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project item-add", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "item-add"}
 
 		// Add positional argument: number
@@ -650,16 +1142,23 @@ func RegisterProjectItemAddTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--template", args.Template)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_item_add", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project item-add failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project item-add", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -671,14 +1170,47 @@ type ProjectItemCreateArgs struct {
 	Format string `json:"format,omitempty" jsonschema:"Output format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectItemCreateInputSpec holds the argument constraints for gh project item-create
+var projectItemCreateInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner", "title"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectItemCreateTool registers the gh project item-create tool
-func RegisterProjectItemCreateTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectItemCreateTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_item_create",
 		Description: "Create a draft issue item in a project",
+		InputSchema: toolkit.InputSchema[ProjectItemCreateArgs](projectItemCreateInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Create Project Draft Issue",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectItemCreateArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectItemCreateInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project item-create", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project item-create", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project item-create", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "item-create"}
 
 		// Add positional argument: number
@@ -702,16 +1234,23 @@ func RegisterProjectItemCreateTool(server *mcp.Server, exec *executor.Executor)
 			cmd = append(cmd, "--format", args.Format)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_item_create", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project item-create failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project item-create", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -729,14 +1268,47 @@ type ProjectItemEditArgs struct {
 	Format               string `json:"format,omitempty" jsonschema:"Output format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectItemEditInputSpec holds the argument constraints for gh project item-edit
+var projectItemEditInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner", "id"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectItemEditTool registers the gh project item-edit tool
-func RegisterProjectItemEditTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectItemEditTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_item_edit",
 		Description: "Edit an item in a project",
+		InputSchema: toolkit.InputSchema[ProjectItemEditArgs](projectItemEditInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Edit Project Item",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectItemEditArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectItemEditInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project item-edit", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project item-edit", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project item-edit", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "item-edit"}
 
 		// Add positional argument: number
@@ -784,16 +1356,23 @@ func RegisterProjectItemEditTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--format", args.Format)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_item_edit", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project item-edit failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project item-edit", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -804,14 +1383,48 @@ type ProjectItemDeleteArgs struct {
 	Format string `json:"format,omitempty" jsonschema:"Output format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// projectItemDeleteInputSpec holds the argument constraints for gh project item-delete
+var projectItemDeleteInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner", "id"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectItemDeleteTool registers the gh project item-delete tool
-func RegisterProjectItemDeleteTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectItemDeleteTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_item_delete",
 		Description: "Delete an item from a project",
+		InputSchema: toolkit.InputSchema[ProjectItemDeleteArgs](projectItemDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete Project Item",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectItemDeleteArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectItemDeleteInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project item-delete", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project item-delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project item-delete", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "item-delete"}
 
 		// Add positional argument: number
@@ -831,16 +1444,29 @@ func RegisterProjectItemDeleteTool(server *mcp.Server, exec *executor.Executor)
 			cmd = append(cmd, "--format", args.Format)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh project item-delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_project_item_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project item-delete failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project item-delete", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -852,14 +1478,47 @@ type ProjectItemArchiveArgs struct {
 	Format string `json:"format,omitempty" jsonschema:"Output format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectItemArchiveInputSpec holds the argument constraints for gh project item-archive
+var projectItemArchiveInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner", "id"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectItemArchiveTool registers the gh project item-archive tool
-func RegisterProjectItemArchiveTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectItemArchiveTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_item_archive",
 		Description: "Archive an item in a project",
+		InputSchema: toolkit.InputSchema[ProjectItemArchiveArgs](projectItemArchiveInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Archive Project Item",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectItemArchiveArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectItemArchiveInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project item-archive", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project item-archive", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project item-archive", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "item-archive"}
 
 		// Add positional argument: number
@@ -883,16 +1542,23 @@ func RegisterProjectItemArchiveTool(server *mcp.Server, exec *executor.Executor)
 			cmd = append(cmd, "--format", args.Format)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_item_archive", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project item-archive failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project item-archive", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -904,14 +1570,47 @@ type ProjectLinkArgs struct {
 	Format string `json:"format,omitempty" jsonschema:"Output format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectLinkInputSpec holds the argument constraints for gh project link
+var projectLinkInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectLinkTool registers the gh project link tool
-func RegisterProjectLinkTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectLinkTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_link",
 		Description: "Link a project to a repository or team",
+		InputSchema: toolkit.InputSchema[ProjectLinkArgs](projectLinkInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Link Project",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectLinkArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectLinkInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project link", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project link", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project link", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "link"}
 
 		// Add positional argument: number
@@ -935,16 +1634,23 @@ func RegisterProjectLinkTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--format", args.Format)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_link", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project link failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project link", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -956,14 +1662,47 @@ type ProjectUnlinkArgs struct {
 	Format string `json:"format,omitempty" jsonschema:"Output format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectUnlinkInputSpec holds the argument constraints for gh project unlink
+var projectUnlinkInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectUnlinkTool registers the gh project unlink tool
-func RegisterProjectUnlinkTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectUnlinkTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_unlink",
 		Description: "Unlink a project from a repository or team",
+		InputSchema: toolkit.InputSchema[ProjectUnlinkArgs](projectUnlinkInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Unlink Project",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectUnlinkArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectUnlinkInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project unlink", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project unlink", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project unlink", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "unlink"}
 
 		// Add positional argument: number
@@ -987,16 +1726,23 @@ func RegisterProjectUnlinkTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--format", args.Format)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_unlink", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project unlink failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project unlink", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -1007,14 +1753,47 @@ type ProjectMarkTemplateArgs struct {
 	Format string `json:"format,omitempty" jsonschema:"Output format"`
 
 	Number string `json:"number,omitempty" jsonschema:"Project number (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// projectMarkTemplateInputSpec holds the argument constraints for gh project mark-template
+var projectMarkTemplateInputSpec = toolkit.InputSpec{
+	Required: []string{"number", "owner"},
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json"}},
+	},
 }
 
 // RegisterProjectMarkTemplateTool registers the gh project mark-template tool
-func RegisterProjectMarkTemplateTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterProjectMarkTemplateTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_project_mark_template",
 		Description: "Mark a project as a template",
+		InputSchema: toolkit.InputSchema[ProjectMarkTemplateArgs](projectMarkTemplateInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Mark Project as Template",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ProjectMarkTemplateArgs) (*mcp.CallToolResult, any, error) {
+		if err := projectMarkTemplateInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh project mark-template", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh project mark-template", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh project mark-template", nil, err)), nil, nil
+		}
+
 		cmd := []string{"project", "mark-template"}
 
 		// Add positional argument: number
@@ -1034,15 +1813,45 @@ func RegisterProjectMarkTemplateTool(server *mcp.Server, exec *executor.Executor
 			cmd = append(cmd, "--format", args.Format)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_project_mark_template", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh project mark-template failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh project mark-template", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterProjectTools registers the gh project tools
+func RegisterProjectTools(server *mcp.Server, exec executor.Runner) {
+	RegisterProjectCreateTool(server, exec)
+	RegisterProjectListTool(server, exec)
+	RegisterProjectViewTool(server, exec)
+	RegisterProjectEditTool(server, exec)
+	RegisterProjectCloseTool(server, exec)
+	RegisterProjectDeleteTool(server, exec)
+	RegisterProjectCopyTool(server, exec)
+	RegisterProjectFieldListTool(server, exec)
+	RegisterProjectFieldCreateTool(server, exec)
+	RegisterProjectFieldDeleteTool(server, exec)
+	RegisterProjectItemListTool(server, exec)
+	RegisterProjectItemAddTool(server, exec)
+	RegisterProjectItemCreateTool(server, exec)
+	RegisterProjectItemEditTool(server, exec)
+	RegisterProjectItemDeleteTool(server, exec)
+	RegisterProjectItemArchiveTool(server, exec)
+	RegisterProjectLinkTool(server, exec)
+	RegisterProjectUnlinkTool(server, exec)
+	RegisterProjectMarkTemplateTool(server, exec)
+}
@@ -0,0 +1,30 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestProjectArgv calls every gh project tool with representative
+// arguments and compares the commands they run with testdata/project.golden
+func TestProjectArgv(t *testing.T) {
+	checkArgvGolden(t, "project", []argvCase{
+		{Tool: "gh_project_create", Args: map[string]any{"owner": "sample-owner", "title": "sample-title", "format": "json", "jq": "sample-jq", "template": "sample-template"}},
+		{Tool: "gh_project_list", Args: map[string]any{"owner": "sample-owner", "closed": true, "limit": 1, "format": "json", "jq": "sample-jq", "template": "sample-template", "web": true}},
+		{Tool: "gh_project_view", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "format": "json", "jq": "sample-jq", "template": "sample-template", "web": true}},
+		{Tool: "gh_project_edit", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "title": "sample-title", "description": "sample-description", "readme": "sample-readme", "visibility": "PUBLIC", "format": "json", "jq": "sample-jq", "template": "sample-template"}},
+		{Tool: "gh_project_close", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "undo": true, "format": "json"}},
+		{Tool: "gh_project_delete", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "format": "json"}},
+		{Tool: "gh_project_copy", Args: map[string]any{"number": "sample-number", "source_owner": "sample-source-owner", "target_owner": "sample-target-owner", "title": "sample-title", "drafts": true, "format": "json"}},
+		{Tool: "gh_project_field_list", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "limit": 1, "format": "json", "jq": "sample-jq", "template": "sample-template"}},
+		{Tool: "gh_project_field_create", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "name": "sample-name", "data_type": "TEXT", "format": "json"}},
+		{Tool: "gh_project_field_delete", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "id": "sample-id", "format": "json"}},
+		{Tool: "gh_project_item_list", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "limit": 1, "format": "json", "jq": "sample-jq", "template": "sample-template"}},
+		{Tool: "gh_project_item_add", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "url": "sample-url", "format": "json", "jq": "sample-jq", "template": "sample-template"}},
+		{Tool: "gh_project_item_create", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "title": "sample-title", "body": "sample-body", "format": "json"}},
+		{Tool: "gh_project_item_edit", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "id": "sample-id", "field_id": "sample-field-id", "text": "sample-text", "number_value": "sample-number-value", "date": "sample-date", "single_select_option_id": "sample-single-select-option-id", "iteration_id": "sample-iteration-id", "clear": true, "format": "json"}},
+		{Tool: "gh_project_item_delete", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "id": "sample-id", "format": "json"}},
+		{Tool: "gh_project_item_archive", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "id": "sample-id", "undo": true, "format": "json"}},
+		{Tool: "gh_project_link", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "repo": "sample-repo", "team": "sample-team", "format": "json"}},
+		{Tool: "gh_project_unlink", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "repo": "sample-repo", "team": "sample-team", "format": "json"}},
+		{Tool: "gh_project_mark_template", Args: map[string]any{"number": "sample-number", "owner": "sample-owner", "undo": true, "format": "json"}},
+	})
+}
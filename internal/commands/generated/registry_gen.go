@@ -3,188 +3,259 @@ package generated
 
 import (
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolset"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
-// RegisterAllTools registers all generated gh command tools
-func RegisterAllTools(server *mcp.Server, exec *executor.Executor) {
-	RegisterAliasListTool(server, exec)
-	RegisterAliasSetTool(server, exec)
-	RegisterAliasDeleteTool(server, exec)
-	RegisterAliasImportTool(server, exec)
-
-	RegisterApiRequestTool(server, exec)
-
-	RegisterAttestationVerifyTool(server, exec)
-	RegisterAttestationDownloadTool(server, exec)
-	RegisterAttestationTrustedRootTool(server, exec)
-
-	RegisterAuthLoginTool(server, exec)
-	RegisterAuthLogoutTool(server, exec)
-	RegisterAuthRefreshTool(server, exec)
-	RegisterAuthStatusTool(server, exec)
-	RegisterAuthTokenTool(server, exec)
-	RegisterAuthSetupGitTool(server, exec)
-
-	RegisterBrowseBrowseTool(server, exec)
-
-	RegisterCacheListTool(server, exec)
-	RegisterCacheDeleteTool(server, exec)
-
-	RegisterCodespaceListTool(server, exec)
-	RegisterCodespaceCreateTool(server, exec)
-	RegisterCodespaceDeleteTool(server, exec)
-	RegisterCodespaceViewTool(server, exec)
-	RegisterCodespaceStopTool(server, exec)
-	RegisterCodespaceSshTool(server, exec)
-	RegisterCodespaceLogsTool(server, exec)
-	RegisterCodespacePortsTool(server, exec)
-	RegisterCodespaceEditTool(server, exec)
-	RegisterCodespaceRebuildTool(server, exec)
-	RegisterCodespaceCodeTool(server, exec)
-	RegisterCodespaceJupyterTool(server, exec)
-	RegisterCodespaceCpTool(server, exec)
-
-	RegisterCompletionCompletionTool(server, exec)
-
-	RegisterConfigListTool(server, exec)
-	RegisterConfigGetTool(server, exec)
-	RegisterConfigSetTool(server, exec)
-	RegisterConfigClearCacheTool(server, exec)
-
-	RegisterExtensionListTool(server, exec)
-	RegisterExtensionInstallTool(server, exec)
-	RegisterExtensionRemoveTool(server, exec)
-	RegisterExtensionUpgradeTool(server, exec)
-	RegisterExtensionSearchTool(server, exec)
-	RegisterExtensionCreateTool(server, exec)
-	RegisterExtensionExecTool(server, exec)
-	RegisterExtensionBrowseTool(server, exec)
-
-	RegisterGistCreateTool(server, exec)
-	RegisterGistListTool(server, exec)
-	RegisterGistViewTool(server, exec)
-	RegisterGistEditTool(server, exec)
-	RegisterGistDeleteTool(server, exec)
-	RegisterGistCloneTool(server, exec)
-
-	RegisterGpgKeyListTool(server, exec)
-	RegisterGpgKeyAddTool(server, exec)
-	RegisterGpgKeyDeleteTool(server, exec)
-
-	RegisterIssueCreateTool(server, exec)
-	RegisterIssueListTool(server, exec)
-	RegisterIssueViewTool(server, exec)
-	RegisterIssueCloseTool(server, exec)
-	RegisterIssueCommentTool(server, exec)
-	RegisterIssueDeleteTool(server, exec)
-	RegisterIssueEditTool(server, exec)
-	RegisterIssueLockTool(server, exec)
-	RegisterIssuePinTool(server, exec)
-	RegisterIssueReopenTool(server, exec)
-	RegisterIssueStatusTool(server, exec)
-	RegisterIssueTransferTool(server, exec)
-	RegisterIssueUnlockTool(server, exec)
-	RegisterIssueUnpinTool(server, exec)
-
-	RegisterLabelCreateTool(server, exec)
-	RegisterLabelListTool(server, exec)
-	RegisterLabelEditTool(server, exec)
-	RegisterLabelDeleteTool(server, exec)
-	RegisterLabelCloneTool(server, exec)
-
-	RegisterOrgListTool(server, exec)
-
-	RegisterPrCreateTool(server, exec)
-	RegisterPrListTool(server, exec)
-	RegisterPrViewTool(server, exec)
-	RegisterPrCloseTool(server, exec)
-	RegisterPrMergeTool(server, exec)
-	RegisterPrCheckoutTool(server, exec)
-	RegisterPrChecksTool(server, exec)
-	RegisterPrDiffTool(server, exec)
-	RegisterPrCommentTool(server, exec)
-	RegisterPrEditTool(server, exec)
-	RegisterPrReadyTool(server, exec)
-	RegisterPrReopenTool(server, exec)
-	RegisterPrReviewTool(server, exec)
-	RegisterPrStatusTool(server, exec)
-
-	RegisterProjectCreateTool(server, exec)
-	RegisterProjectListTool(server, exec)
-	RegisterProjectViewTool(server, exec)
-	RegisterProjectEditTool(server, exec)
-	RegisterProjectCloseTool(server, exec)
-	RegisterProjectDeleteTool(server, exec)
-	RegisterProjectCopyTool(server, exec)
-	RegisterProjectFieldListTool(server, exec)
-	RegisterProjectFieldCreateTool(server, exec)
-	RegisterProjectFieldDeleteTool(server, exec)
-	RegisterProjectItemListTool(server, exec)
-	RegisterProjectItemAddTool(server, exec)
-	RegisterProjectItemCreateTool(server, exec)
-	RegisterProjectItemEditTool(server, exec)
-	RegisterProjectItemDeleteTool(server, exec)
-	RegisterProjectItemArchiveTool(server, exec)
-	RegisterProjectLinkTool(server, exec)
-	RegisterProjectUnlinkTool(server, exec)
-	RegisterProjectMarkTemplateTool(server, exec)
-
-	RegisterReleaseCreateTool(server, exec)
-	RegisterReleaseListTool(server, exec)
-	RegisterReleaseViewTool(server, exec)
-	RegisterReleaseDeleteTool(server, exec)
-	RegisterReleaseDownloadTool(server, exec)
-	RegisterReleaseUploadTool(server, exec)
-	RegisterReleaseEditTool(server, exec)
-
-	RegisterRepoCreateTool(server, exec)
-	RegisterRepoListTool(server, exec)
-	RegisterRepoViewTool(server, exec)
-	RegisterRepoCloneTool(server, exec)
-	RegisterRepoForkTool(server, exec)
-	RegisterRepoDeleteTool(server, exec)
-	RegisterRepoArchiveTool(server, exec)
-	RegisterRepoUnarchiveTool(server, exec)
-	RegisterRepoEditTool(server, exec)
-	RegisterRepoRenameTool(server, exec)
-	RegisterRepoSyncTool(server, exec)
-
-	RegisterRulesetListTool(server, exec)
-	RegisterRulesetViewTool(server, exec)
-	RegisterRulesetCheckTool(server, exec)
-
-	RegisterRunListTool(server, exec)
-	RegisterRunViewTool(server, exec)
-	RegisterRunWatchTool(server, exec)
-	RegisterRunRerunTool(server, exec)
-	RegisterRunCancelTool(server, exec)
-	RegisterRunDeleteTool(server, exec)
-	RegisterRunDownloadTool(server, exec)
-
-	RegisterSearchReposTool(server, exec)
-	RegisterSearchIssuesTool(server, exec)
-	RegisterSearchPrsTool(server, exec)
-
-	RegisterSecretListTool(server, exec)
-	RegisterSecretSetTool(server, exec)
-	RegisterSecretRemoveTool(server, exec)
-
-	RegisterSshKeyListTool(server, exec)
-	RegisterSshKeyAddTool(server, exec)
-	RegisterSshKeyDeleteTool(server, exec)
-
-	RegisterStatusStatusTool(server, exec)
-
-	RegisterVariableSetTool(server, exec)
-	RegisterVariableListTool(server, exec)
-	RegisterVariableGetTool(server, exec)
-	RegisterVariableDeleteTool(server, exec)
-
-	RegisterWorkflowListTool(server, exec)
-	RegisterWorkflowViewTool(server, exec)
-	RegisterWorkflowRunTool(server, exec)
-	RegisterWorkflowEnableTool(server, exec)
-	RegisterWorkflowDisableTool(server, exec)
+// Toolsets lists the generated tools, grouped by gh command
+var Toolsets = []toolset.Toolset{
+	{Name: "alias", Tools: []toolset.Tool{
+		{Name: "gh_alias_list", Access: "read", Register: RegisterAliasListTool},
+		{Name: "gh_alias_set", Access: "write", Register: RegisterAliasSetTool},
+		{Name: "gh_alias_delete", Access: "destructive", Register: RegisterAliasDeleteTool},
+		{Name: "gh_alias_import", Access: "write", Register: RegisterAliasImportTool},
+	}},
+	{Name: "api", Tools: []toolset.Tool{
+		{Name: "gh_api_request", Access: "write", ReadOnlyConstrained: true, Register: RegisterApiRequestTool},
+	}},
+	{Name: "attestation", Tools: []toolset.Tool{
+		{Name: "gh_attestation_verify", Access: "read", Register: RegisterAttestationVerifyTool},
+		{Name: "gh_attestation_download", Access: "write", Register: RegisterAttestationDownloadTool},
+		{Name: "gh_attestation_trusted_root", Access: "read", Register: RegisterAttestationTrustedRootTool},
+	}},
+	{Name: "auth", Tools: []toolset.Tool{
+		{Name: "gh_auth_login", Access: "write", Register: RegisterAuthLoginTool},
+		{Name: "gh_auth_logout", Access: "write", Register: RegisterAuthLogoutTool},
+		{Name: "gh_auth_refresh", Access: "write", Register: RegisterAuthRefreshTool},
+		{Name: "gh_auth_status", Access: "read", Register: RegisterAuthStatusTool},
+		{Name: "gh_auth_token", Access: "read", SensitiveOutput: true, Register: RegisterAuthTokenTool},
+		{Name: "gh_auth_setup_git", Access: "write", Register: RegisterAuthSetupGitTool},
+	}},
+	{Name: "browse", Tools: []toolset.Tool{
+		{Name: "gh_browse_browse", Access: "read", Register: RegisterBrowseBrowseTool},
+	}},
+	{Name: "cache", Tools: []toolset.Tool{
+		{Name: "gh_cache_list", Access: "read", Register: RegisterCacheListTool},
+		{Name: "gh_cache_delete", Access: "destructive", Register: RegisterCacheDeleteTool},
+	}},
+	{Name: "codespace", Tools: []toolset.Tool{
+		{Name: "gh_codespace_list", Access: "read", Register: RegisterCodespaceListTool},
+		{Name: "gh_codespace_create", Access: "write", Register: RegisterCodespaceCreateTool},
+		{Name: "gh_codespace_delete", Access: "destructive", Register: RegisterCodespaceDeleteTool},
+		{Name: "gh_codespace_view", Access: "read", Register: RegisterCodespaceViewTool},
+		{Name: "gh_codespace_stop", Access: "write", Register: RegisterCodespaceStopTool},
+		{Name: "gh_codespace_ssh", Access: "write", Register: RegisterCodespaceSshTool},
+		{Name: "gh_codespace_logs", Access: "read", Register: RegisterCodespaceLogsTool},
+		{Name: "gh_codespace_ports_list", Access: "read", Register: RegisterCodespacePortsListTool},
+		{Name: "gh_codespace_edit", Access: "write", Register: RegisterCodespaceEditTool},
+		{Name: "gh_codespace_rebuild", Access: "write", Register: RegisterCodespaceRebuildTool},
+		{Name: "gh_codespace_code", Access: "write", Register: RegisterCodespaceCodeTool},
+		{Name: "gh_codespace_jupyter", Access: "write", Register: RegisterCodespaceJupyterTool},
+		{Name: "gh_codespace_cp", Access: "write", Register: RegisterCodespaceCpTool},
+		{Name: "gh_codespace_ports_visibility", Access: "write", Register: RegisterCodespacePortsVisibilityTool},
+	}},
+	{Name: "completion", Tools: []toolset.Tool{
+		{Name: "gh_completion_completion", Access: "read", Register: RegisterCompletionCompletionTool},
+	}},
+	{Name: "config", Tools: []toolset.Tool{
+		{Name: "gh_config_list", Access: "read", Register: RegisterConfigListTool},
+		{Name: "gh_config_get", Access: "read", Register: RegisterConfigGetTool},
+		{Name: "gh_config_set", Access: "write", Register: RegisterConfigSetTool},
+		{Name: "gh_config_clear_cache", Access: "write", Register: RegisterConfigClearCacheTool},
+	}},
+	{Name: "extension", Tools: []toolset.Tool{
+		{Name: "gh_extension_list", Access: "read", Register: RegisterExtensionListTool},
+		{Name: "gh_extension_install", Access: "write", Register: RegisterExtensionInstallTool},
+		{Name: "gh_extension_remove", Access: "destructive", Register: RegisterExtensionRemoveTool},
+		{Name: "gh_extension_upgrade", Access: "write", Register: RegisterExtensionUpgradeTool},
+		{Name: "gh_extension_search", Access: "read", Register: RegisterExtensionSearchTool},
+		{Name: "gh_extension_create", Access: "write", Register: RegisterExtensionCreateTool},
+		{Name: "gh_extension_exec", Access: "write", Register: RegisterExtensionExecTool},
+		{Name: "gh_extension_browse", Access: "read", Register: RegisterExtensionBrowseTool},
+	}},
+	{Name: "gist", Tools: []toolset.Tool{
+		{Name: "gh_gist_create", Access: "write", Register: RegisterGistCreateTool},
+		{Name: "gh_gist_list", Access: "read", Register: RegisterGistListTool},
+		{Name: "gh_gist_view", Access: "read", Register: RegisterGistViewTool},
+		{Name: "gh_gist_edit", Access: "write", Register: RegisterGistEditTool},
+		{Name: "gh_gist_delete", Access: "destructive", Register: RegisterGistDeleteTool},
+		{Name: "gh_gist_clone", Access: "write", Register: RegisterGistCloneTool},
+	}},
+	{Name: "gpg-key", Tools: []toolset.Tool{
+		{Name: "gh_gpg-key_list", Access: "read", Register: RegisterGpgKeyListTool},
+		{Name: "gh_gpg-key_add", Access: "write", Register: RegisterGpgKeyAddTool},
+		{Name: "gh_gpg-key_delete", Access: "destructive", Register: RegisterGpgKeyDeleteTool},
+	}},
+	{Name: "issue", Tools: []toolset.Tool{
+		{Name: "gh_issue_create", Access: "write", Register: RegisterIssueCreateTool},
+		{Name: "gh_issue_list", Access: "read", Register: RegisterIssueListTool},
+		{Name: "gh_issue_view", Access: "read", Register: RegisterIssueViewTool},
+		{Name: "gh_issue_close", Access: "write", Register: RegisterIssueCloseTool},
+		{Name: "gh_issue_comment", Access: "write", Register: RegisterIssueCommentTool},
+		{Name: "gh_issue_delete", Access: "destructive", Register: RegisterIssueDeleteTool},
+		{Name: "gh_issue_edit", Access: "write", Register: RegisterIssueEditTool},
+		{Name: "gh_issue_lock", Access: "write", Register: RegisterIssueLockTool},
+		{Name: "gh_issue_pin", Access: "write", Register: RegisterIssuePinTool},
+		{Name: "gh_issue_reopen", Access: "write", Register: RegisterIssueReopenTool},
+		{Name: "gh_issue_status", Access: "read", Register: RegisterIssueStatusTool},
+		{Name: "gh_issue_transfer", Access: "write", Register: RegisterIssueTransferTool},
+		{Name: "gh_issue_unlock", Access: "write", Register: RegisterIssueUnlockTool},
+		{Name: "gh_issue_unpin", Access: "write", Register: RegisterIssueUnpinTool},
+	}},
+	{Name: "label", Tools: []toolset.Tool{
+		{Name: "gh_label_create", Access: "write", Register: RegisterLabelCreateTool},
+		{Name: "gh_label_list", Access: "read", Register: RegisterLabelListTool},
+		{Name: "gh_label_edit", Access: "write", Register: RegisterLabelEditTool},
+		{Name: "gh_label_delete", Access: "destructive", Register: RegisterLabelDeleteTool},
+		{Name: "gh_label_clone", Access: "write", Register: RegisterLabelCloneTool},
+	}},
+	{Name: "org", Tools: []toolset.Tool{
+		{Name: "gh_org_list", Access: "read", Register: RegisterOrgListTool},
+	}},
+	{Name: "pr", Tools: []toolset.Tool{
+		{Name: "gh_pr_create", Access: "write", Register: RegisterPrCreateTool},
+		{Name: "gh_pr_list", Access: "read", Register: RegisterPrListTool},
+		{Name: "gh_pr_view", Access: "read", Register: RegisterPrViewTool},
+		{Name: "gh_pr_close", Access: "write", Register: RegisterPrCloseTool},
+		{Name: "gh_pr_merge", Access: "write", Register: RegisterPrMergeTool},
+		{Name: "gh_pr_checkout", Access: "write", Register: RegisterPrCheckoutTool},
+		{Name: "gh_pr_checks", Access: "read", Register: RegisterPrChecksTool},
+		{Name: "gh_pr_diff", Access: "read", Register: RegisterPrDiffTool},
+		{Name: "gh_pr_comment", Access: "write", Register: RegisterPrCommentTool},
+		{Name: "gh_pr_edit", Access: "write", Register: RegisterPrEditTool},
+		{Name: "gh_pr_ready", Access: "write", Register: RegisterPrReadyTool},
+		{Name: "gh_pr_reopen", Access: "write", Register: RegisterPrReopenTool},
+		{Name: "gh_pr_review", Access: "write", Register: RegisterPrReviewTool},
+		{Name: "gh_pr_status", Access: "read", Register: RegisterPrStatusTool},
+	}},
+	{Name: "project", Tools: []toolset.Tool{
+		{Name: "gh_project_create", Access: "write", Register: RegisterProjectCreateTool},
+		{Name: "gh_project_list", Access: "read", Register: RegisterProjectListTool},
+		{Name: "gh_project_view", Access: "read", Register: RegisterProjectViewTool},
+		{Name: "gh_project_edit", Access: "write", Register: RegisterProjectEditTool},
+		{Name: "gh_project_close", Access: "write", Register: RegisterProjectCloseTool},
+		{Name: "gh_project_delete", Access: "destructive", Register: RegisterProjectDeleteTool},
+		{Name: "gh_project_copy", Access: "write", Register: RegisterProjectCopyTool},
+		{Name: "gh_project_field_list", Access: "read", Register: RegisterProjectFieldListTool},
+		{Name: "gh_project_field_create", Access: "write", Register: RegisterProjectFieldCreateTool},
+		{Name: "gh_project_field_delete", Access: "destructive", Register: RegisterProjectFieldDeleteTool},
+		{Name: "gh_project_item_list", Access: "read", Register: RegisterProjectItemListTool},
+		{Name: "gh_project_item_add", Access: "write", Register: RegisterProjectItemAddTool},
+		{Name: "gh_project_item_create", Access: "write", Register: RegisterProjectItemCreateTool},
+		{Name: "gh_project_item_edit", Access: "write", Register: RegisterProjectItemEditTool},
+		{Name: "gh_project_item_delete", Access: "destructive", Register: RegisterProjectItemDeleteTool},
+		{Name: "gh_project_item_archive", Access: "write", Register: RegisterProjectItemArchiveTool},
+		{Name: "gh_project_link", Access: "write", Register: RegisterProjectLinkTool},
+		{Name: "gh_project_unlink", Access: "write", Register: RegisterProjectUnlinkTool},
+		{Name: "gh_project_mark_template", Access: "write", Register: RegisterProjectMarkTemplateTool},
+	}},
+	{Name: "release", Tools: []toolset.Tool{
+		{Name: "gh_release_create", Access: "write", Register: RegisterReleaseCreateTool},
+		{Name: "gh_release_list", Access: "read", Register: RegisterReleaseListTool},
+		{Name: "gh_release_view", Access: "read", Register: RegisterReleaseViewTool},
+		{Name: "gh_release_delete", Access: "destructive", Register: RegisterReleaseDeleteTool},
+		{Name: "gh_release_download", Access: "write", Register: RegisterReleaseDownloadTool},
+		{Name: "gh_release_upload", Access: "write", Register: RegisterReleaseUploadTool},
+		{Name: "gh_release_edit", Access: "write", Register: RegisterReleaseEditTool},
+	}},
+	{Name: "repo", Tools: []toolset.Tool{
+		{Name: "gh_repo_create", Access: "write", Register: RegisterRepoCreateTool},
+		{Name: "gh_repo_list", Access: "read", Register: RegisterRepoListTool},
+		{Name: "gh_repo_view", Access: "read", Register: RegisterRepoViewTool},
+		{Name: "gh_repo_clone", Access: "write", Register: RegisterRepoCloneTool},
+		{Name: "gh_repo_fork", Access: "write", Register: RegisterRepoForkTool},
+		{Name: "gh_repo_delete", Access: "destructive", Register: RegisterRepoDeleteTool},
+		{Name: "gh_repo_archive", Access: "write", Register: RegisterRepoArchiveTool},
+		{Name: "gh_repo_unarchive", Access: "write", Register: RegisterRepoUnarchiveTool},
+		{Name: "gh_repo_edit", Access: "write", Register: RegisterRepoEditTool},
+		{Name: "gh_repo_rename", Access: "write", Register: RegisterRepoRenameTool},
+		{Name: "gh_repo_sync", Access: "write", Register: RegisterRepoSyncTool},
+		{Name: "gh_repo_deploy_key_list", Access: "read", Register: RegisterRepoDeployKeyListTool},
+		{Name: "gh_repo_deploy_key_add", Access: "write", Register: RegisterRepoDeployKeyAddTool},
+		{Name: "gh_repo_deploy_key_delete", Access: "destructive", Register: RegisterRepoDeployKeyDeleteTool},
+		{Name: "gh_repo_autolink_list", Access: "read", Register: RegisterRepoAutolinkListTool},
+		{Name: "gh_repo_autolink_create", Access: "write", Register: RegisterRepoAutolinkCreateTool},
+		{Name: "gh_repo_autolink_view", Access: "read", Register: RegisterRepoAutolinkViewTool},
+		{Name: "gh_repo_autolink_delete", Access: "destructive", Register: RegisterRepoAutolinkDeleteTool},
+		{Name: "gh_repo_gitignore_list", Access: "read", Register: RegisterRepoGitignoreListTool},
+		{Name: "gh_repo_gitignore_view", Access: "read", Register: RegisterRepoGitignoreViewTool},
+		{Name: "gh_repo_license_list", Access: "read", Register: RegisterRepoLicenseListTool},
+		{Name: "gh_repo_license_view", Access: "read", Register: RegisterRepoLicenseViewTool},
+	}},
+	{Name: "ruleset", Tools: []toolset.Tool{
+		{Name: "gh_ruleset_list", Access: "read", Register: RegisterRulesetListTool},
+		{Name: "gh_ruleset_view", Access: "read", Register: RegisterRulesetViewTool},
+		{Name: "gh_ruleset_check", Access: "read", Register: RegisterRulesetCheckTool},
+	}},
+	{Name: "run", Tools: []toolset.Tool{
+		{Name: "gh_run_list", Access: "read", Register: RegisterRunListTool},
+		{Name: "gh_run_view", Access: "read", Register: RegisterRunViewTool},
+		{Name: "gh_run_watch", Access: "read", Register: RegisterRunWatchTool},
+		{Name: "gh_run_rerun", Access: "write", Register: RegisterRunRerunTool},
+		{Name: "gh_run_cancel", Access: "write", Register: RegisterRunCancelTool},
+		{Name: "gh_run_delete", Access: "destructive", Register: RegisterRunDeleteTool},
+		{Name: "gh_run_download", Access: "write", Register: RegisterRunDownloadTool},
+	}},
+	{Name: "search", Tools: []toolset.Tool{
+		{Name: "gh_search_repos", Access: "read", Register: RegisterSearchReposTool},
+		{Name: "gh_search_issues", Access: "read", Register: RegisterSearchIssuesTool},
+		{Name: "gh_search_prs", Access: "read", Register: RegisterSearchPrsTool},
+	}},
+	{Name: "secret", Tools: []toolset.Tool{
+		{Name: "gh_secret_list", Access: "read", Register: RegisterSecretListTool},
+		{Name: "gh_secret_set", Access: "write", Register: RegisterSecretSetTool},
+		{Name: "gh_secret_remove", Access: "destructive", Register: RegisterSecretRemoveTool},
+	}},
+	{Name: "ssh-key", Tools: []toolset.Tool{
+		{Name: "gh_ssh-key_list", Access: "read", Register: RegisterSshKeyListTool},
+		{Name: "gh_ssh-key_add", Access: "write", Register: RegisterSshKeyAddTool},
+		{Name: "gh_ssh-key_delete", Access: "destructive", Register: RegisterSshKeyDeleteTool},
+	}},
+	{Name: "status", Tools: []toolset.Tool{
+		{Name: "gh_status_status", Access: "read", Register: RegisterStatusStatusTool},
+	}},
+	{Name: "variable", Tools: []toolset.Tool{
+		{Name: "gh_variable_set", Access: "write", Register: RegisterVariableSetTool},
+		{Name: "gh_variable_list", Access: "read", Register: RegisterVariableListTool},
+		{Name: "gh_variable_get", Access: "read", Register: RegisterVariableGetTool},
+		{Name: "gh_variable_delete", Access: "destructive", Register: RegisterVariableDeleteTool},
+	}},
+	{Name: "workflow", Tools: []toolset.Tool{
+		{Name: "gh_workflow_list", Access: "read", Register: RegisterWorkflowListTool},
+		{Name: "gh_workflow_view", Access: "read", Register: RegisterWorkflowViewTool},
+		{Name: "gh_workflow_run", Access: "write", Register: RegisterWorkflowRunTool},
+		{Name: "gh_workflow_enable", Access: "write", Register: RegisterWorkflowEnableTool},
+		{Name: "gh_workflow_disable", Access: "write", Register: RegisterWorkflowDisableTool},
+	}},
+}
 
+// RegisterAllTools registers all generated gh command tools
+func RegisterAllTools(server *mcp.Server, exec executor.Runner) {
+	RegisterAliasTools(server, exec)
+	RegisterApiTools(server, exec)
+	RegisterAttestationTools(server, exec)
+	RegisterAuthTools(server, exec)
+	RegisterBrowseTools(server, exec)
+	RegisterCacheTools(server, exec)
+	RegisterCodespaceTools(server, exec)
+	RegisterCompletionTools(server, exec)
+	RegisterConfigTools(server, exec)
+	RegisterExtensionTools(server, exec)
+	RegisterGistTools(server, exec)
+	RegisterGpgKeyTools(server, exec)
+	RegisterIssueTools(server, exec)
+	RegisterLabelTools(server, exec)
+	RegisterOrgTools(server, exec)
+	RegisterPrTools(server, exec)
+	RegisterProjectTools(server, exec)
+	RegisterReleaseTools(server, exec)
+	RegisterRepoTools(server, exec)
+	RegisterRulesetTools(server, exec)
+	RegisterRunTools(server, exec)
+	RegisterSearchTools(server, exec)
+	RegisterSecretTools(server, exec)
+	RegisterSshKeyTools(server, exec)
+	RegisterStatusTools(server, exec)
+	RegisterVariableTools(server, exec)
+	RegisterWorkflowTools(server, exec)
 }
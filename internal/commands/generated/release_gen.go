@@ -2,9 +2,12 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -24,14 +27,44 @@ type ReleaseCreateArgs struct {
 	Repo               string `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	Tag string `json:"tag,omitempty" jsonschema:"Tag name (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// releaseCreateInputSpec holds the argument constraints for gh release create
+var releaseCreateInputSpec = toolkit.InputSpec{
+	Required: []string{"tag"},
 }
 
 // RegisterReleaseCreateTool registers the gh release create tool
-func RegisterReleaseCreateTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterReleaseCreateTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_release_create",
 		Description: "Create a new release",
+		InputSchema: toolkit.InputSchema[ReleaseCreateArgs](releaseCreateInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Create Release",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ReleaseCreateArgs) (*mcp.CallToolResult, any, error) {
+		if err := releaseCreateInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh release create", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh release create", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh release create", nil, err)), nil, nil
+		}
+
 		cmd := []string{"release", "create"}
 
 		// Add positional argument: tag
@@ -87,16 +120,23 @@ func RegisterReleaseCreateTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_release_create", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 1800),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh release create failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh release create", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -105,18 +145,71 @@ type ReleaseListArgs struct {
 	ExcludeDrafts      bool     `json:"exclude_drafts,omitempty" jsonschema:"Exclude draft releases"`
 	ExcludePreReleases bool     `json:"exclude_pre_releases,omitempty" jsonschema:"Exclude pre-releases"`
 	Limit              int      `json:"limit,omitempty" jsonschema:"Maximum number of items to fetch"`
-	Json               []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json               []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: tagName,name,isDraft,isPrerelease,isLatest,publishedAt)"`
 	Jq                 string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template           string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Repo               string   `json:"repo,omitempty" jsonschema:"Select repository"`
+	Format             string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// releaseListInputSpec holds the argument constraints for gh release list
+var releaseListInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
 }
 
+// releaseListOutputSpec describes the --json output of gh release list
+var releaseListOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "createdAt", Type: "string", Description: "When the release was created"},
+		{Name: "isDraft", Type: "boolean", Description: "Whether the release is a draft"},
+		{Name: "isImmutable", Type: "boolean", Description: "Whether the release is immutable"},
+		{Name: "isLatest", Type: "boolean", Description: "Whether the release is the latest"},
+		{Name: "isPrerelease", Type: "boolean", Description: "Whether the release is a prerelease"},
+		{Name: "name", Type: "string", Description: "Release name"},
+		{Name: "publishedAt", Type: "string", Description: "When the release was published"},
+		{Name: "tagName", Type: "string", Description: "Tag name"},
+	},
+}
+
+// releaseListDefaultJSONFields are requested when no json fields are given
+var releaseListDefaultJSONFields = []string{"tagName", "name", "isDraft", "isPrerelease", "isLatest", "publishedAt"}
+
 // RegisterReleaseListTool registers the gh release list tool
-func RegisterReleaseListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterReleaseListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_release_list",
-		Description: "List releases in a repository",
+		Name:         "gh_release_list",
+		Description:  "List releases in a repository",
+		InputSchema:  toolkit.InputSchema[ReleaseListArgs](releaseListInputSpec),
+		OutputSchema: toolkit.OutputSchema(releaseListOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Releases",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ReleaseListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh release list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh release list", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" {
+			args.Json = releaseListDefaultJSONFields
+		}
+
 		cmd := []string{"release", "list"}
 
 		if args.ExcludeDrafts {
@@ -147,36 +240,105 @@ func RegisterReleaseListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_release_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh release list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh release list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
 // ReleaseViewArgs defines parameters for gh release view
 type ReleaseViewArgs struct {
-	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: tagName,name,body,author,isDraft,isPrerelease,publishedAt,assets,url)"`
 	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Web      bool     `json:"web,omitempty" jsonschema:"Open the release in the browser"`
 	Repo     string   `json:"repo,omitempty" jsonschema:"Select repository"`
+	Format   string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
 
 	Tag string `json:"tag,omitempty" jsonschema:"The tag name or 'latest' (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// releaseViewInputSpec holds the argument constraints for gh release view
+var releaseViewInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
 }
 
+// releaseViewOutputSpec describes the --json output of gh release view
+var releaseViewOutputSpec = toolkit.OutputSpec{
+	Fields: []toolkit.OutputField{
+		{Name: "apiUrl", Type: "string", Description: "API URL"},
+		{Name: "assets", Type: "array", Description: "Release assets"},
+		{Name: "author", Type: "object", Description: "Release author"},
+		{Name: "body", Type: "string", Description: "Release notes"},
+		{Name: "createdAt", Type: "string", Description: "When the release was created"},
+		{Name: "databaseId", Type: "integer", Description: "Release ID"},
+		{Name: "id", Type: "string", Description: "Node ID"},
+		{Name: "isDraft", Type: "boolean", Description: "Whether the release is a draft"},
+		{Name: "isImmutable", Type: "boolean", Description: "Whether the release is immutable"},
+		{Name: "isPrerelease", Type: "boolean", Description: "Whether the release is a prerelease"},
+		{Name: "name", Type: "string", Description: "Release name"},
+		{Name: "publishedAt", Type: "string", Description: "When the release was published"},
+		{Name: "tagName", Type: "string", Description: "Tag name"},
+		{Name: "tarballUrl", Type: "string", Description: "Source tarball URL"},
+		{Name: "targetCommitish", Type: "string", Description: "Target branch or commit"},
+		{Name: "uploadUrl", Type: "string", Description: "Asset upload URL"},
+		{Name: "url", Type: "string", Description: "Release URL"},
+		{Name: "zipballUrl", Type: "string", Description: "Source zipball URL"},
+	},
+}
+
+// releaseViewDefaultJSONFields are requested when no json fields are given
+var releaseViewDefaultJSONFields = []string{"tagName", "name", "body", "author", "isDraft", "isPrerelease", "publishedAt", "assets", "url"}
+
 // RegisterReleaseViewTool registers the gh release view tool
-func RegisterReleaseViewTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterReleaseViewTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_release_view",
-		Description: "View information about a release",
+		Name:         "gh_release_view",
+		Description:  "View information about a release",
+		InputSchema:  toolkit.InputSchema[ReleaseViewArgs](releaseViewInputSpec),
+		OutputSchema: toolkit.OutputSchema(releaseViewOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "View Release",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ReleaseViewArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh release view", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh release view", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" && !args.Web {
+			args.Json = releaseViewDefaultJSONFields
+		}
+
 		cmd := []string{"release", "view"}
 
 		// Add positional argument: tag
@@ -204,16 +366,23 @@ func RegisterReleaseViewTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_release_view", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh release view failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh release view", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, false)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -224,14 +393,45 @@ type ReleaseDeleteArgs struct {
 	Repo       string `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	Tag string `json:"tag,omitempty" jsonschema:"Tag name (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// releaseDeleteInputSpec holds the argument constraints for gh release delete
+var releaseDeleteInputSpec = toolkit.InputSpec{
+	Required: []string{"tag"},
 }
 
 // RegisterReleaseDeleteTool registers the gh release delete tool
-func RegisterReleaseDeleteTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterReleaseDeleteTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_release_delete",
 		Description: "Delete a release",
+		InputSchema: toolkit.InputSchema[ReleaseDeleteArgs](releaseDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete Release",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ReleaseDeleteArgs) (*mcp.CallToolResult, any, error) {
+		if err := releaseDeleteInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh release delete", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh release delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh release delete", nil, err)), nil, nil
+		}
+
 		cmd := []string{"release", "delete"}
 
 		// Add positional argument: tag
@@ -251,16 +451,29 @@ func RegisterReleaseDeleteTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh release delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_release_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh release delete failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh release delete", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -275,14 +488,43 @@ type ReleaseDownloadArgs struct {
 	Repo         string   `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	Tag string `json:"tag,omitempty" jsonschema:"The tag name or 'latest' (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// releaseDownloadInputSpec holds the argument constraints for gh release download
+var releaseDownloadInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"archive": {Enum: []string{"tar.gz", "zip"}},
+	},
 }
 
 // RegisterReleaseDownloadTool registers the gh release download tool
-func RegisterReleaseDownloadTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterReleaseDownloadTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_release_download",
 		Description: "Download release assets",
+		InputSchema: toolkit.InputSchema[ReleaseDownloadArgs](releaseDownloadInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Download Release Assets",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ReleaseDownloadArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh release download", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh release download", nil, err)), nil, nil
+		}
+
 		cmd := []string{"release", "download"}
 
 		// Add positional argument: tag
@@ -318,16 +560,24 @@ func RegisterReleaseDownloadTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_release_download", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 1800),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+			OnLine:      toolkit.Progress(ctx, req),
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh release download failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh release download", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -338,14 +588,44 @@ type ReleaseUploadArgs struct {
 
 	Tag    string   `json:"tag,omitempty" jsonschema:"Tag name (positional argument)"`
 	Assets []string `json:"assets,omitempty" jsonschema:"Asset files to upload (positional arguments)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// releaseUploadInputSpec holds the argument constraints for gh release upload
+var releaseUploadInputSpec = toolkit.InputSpec{
+	Required: []string{"tag"},
 }
 
 // RegisterReleaseUploadTool registers the gh release upload tool
-func RegisterReleaseUploadTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterReleaseUploadTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_release_upload",
 		Description: "Upload assets to a release",
+		InputSchema: toolkit.InputSchema[ReleaseUploadArgs](releaseUploadInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Upload Release Assets",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ReleaseUploadArgs) (*mcp.CallToolResult, any, error) {
+		if err := releaseUploadInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh release upload", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh release upload", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh release upload", nil, err)), nil, nil
+		}
+
 		cmd := []string{"release", "upload"}
 
 		// Add positional argument: tag
@@ -364,26 +644,34 @@ func RegisterReleaseUploadTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_release_upload", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 1800),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+			OnLine:      toolkit.Progress(ctx, req),
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh release upload failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh release upload", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
 // ReleaseEditArgs defines parameters for gh release edit
 type ReleaseEditArgs struct {
-	Draft              bool   `json:"draft,omitempty" jsonschema:"Mark release as a draft"`
-	Latest             bool   `json:"latest,omitempty" jsonschema:"Mark as the latest release"`
+	Draft              *bool  `json:"draft,omitempty" jsonschema:"Mark release as a draft"`
+	Latest             *bool  `json:"latest,omitempty" jsonschema:"Mark as the latest release"`
 	Notes              string `json:"notes,omitempty" jsonschema:"Release notes"`
 	NotesFile          string `json:"notes_file,omitempty" jsonschema:"Read release notes from file"`
-	Prerelease         bool   `json:"prerelease,omitempty" jsonschema:"Mark as a prerelease"`
+	Prerelease         *bool  `json:"prerelease,omitempty" jsonschema:"Mark as a prerelease"`
 	TagName            string `json:"tag_name,omitempty" jsonschema:"Target tag to edit"`
 	Target             string `json:"target,omitempty" jsonschema:"Target branch or commit SHA"`
 	Title              string `json:"title,omitempty" jsonschema:"Release title"`
@@ -392,14 +680,44 @@ type ReleaseEditArgs struct {
 	Repo               string `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	Tag string `json:"tag,omitempty" jsonschema:"Tag name (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// releaseEditInputSpec holds the argument constraints for gh release edit
+var releaseEditInputSpec = toolkit.InputSpec{
+	Required: []string{"tag"},
 }
 
 // RegisterReleaseEditTool registers the gh release edit tool
-func RegisterReleaseEditTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterReleaseEditTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_release_edit",
 		Description: "Edit a release",
+		InputSchema: toolkit.InputSchema[ReleaseEditArgs](releaseEditInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Edit Release",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args ReleaseEditArgs) (*mcp.CallToolResult, any, error) {
+		if err := releaseEditInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh release edit", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh release edit", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh release edit", nil, err)), nil, nil
+		}
+
 		cmd := []string{"release", "edit"}
 
 		// Add positional argument: tag
@@ -407,12 +725,12 @@ func RegisterReleaseEditTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, args.Tag)
 		}
 
-		if args.Draft {
-			cmd = append(cmd, "--draft")
+		if args.Draft != nil {
+			cmd = append(cmd, fmt.Sprintf("--draft=%t", *args.Draft))
 		}
 
-		if args.Latest {
-			cmd = append(cmd, "--latest")
+		if args.Latest != nil {
+			cmd = append(cmd, fmt.Sprintf("--latest=%t", *args.Latest))
 		}
 
 		if args.Notes != "" {
@@ -423,8 +741,8 @@ func RegisterReleaseEditTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--notes-file", args.NotesFile)
 		}
 
-		if args.Prerelease {
-			cmd = append(cmd, "--prerelease")
+		if args.Prerelease != nil {
+			cmd = append(cmd, fmt.Sprintf("--prerelease=%t", *args.Prerelease))
 		}
 
 		if args.TagName != "" {
@@ -451,15 +769,33 @@ func RegisterReleaseEditTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_release_edit", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh release edit failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh release edit", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterReleaseTools registers the gh release tools
+func RegisterReleaseTools(server *mcp.Server, exec executor.Runner) {
+	RegisterReleaseCreateTool(server, exec)
+	RegisterReleaseListTool(server, exec)
+	RegisterReleaseViewTool(server, exec)
+	RegisterReleaseDeleteTool(server, exec)
+	RegisterReleaseDownloadTool(server, exec)
+	RegisterReleaseUploadTool(server, exec)
+	RegisterReleaseEditTool(server, exec)
+}
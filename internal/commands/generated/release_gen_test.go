@@ -0,0 +1,18 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestReleaseArgv calls every gh release tool with representative
+// arguments and compares the commands they run with testdata/release.golden
+func TestReleaseArgv(t *testing.T) {
+	checkArgvGolden(t, "release", []argvCase{
+		{Tool: "gh_release_create", Args: map[string]any{"tag": "sample-tag", "draft": true, "generate_notes": true, "latest": true, "notes": "sample-notes", "notes_file": "sample-notes-file", "notes_start_tag": "sample-notes-start-tag", "prerelease": true, "target": "sample-target", "title": "sample-title", "verify_tag": true, "discussion_category": "sample-discussion-category", "repo": "sample-repo"}},
+		{Tool: "gh_release_list", Args: map[string]any{"exclude_drafts": true, "exclude_pre_releases": true, "limit": 1, "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "repo": "sample-repo", "format": "json"}},
+		{Tool: "gh_release_view", Args: map[string]any{"tag": "sample-tag", "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "web": true, "repo": "sample-repo", "format": "json"}},
+		{Tool: "gh_release_delete", Args: map[string]any{"tag": "sample-tag", "cleanup_tag": true, "yes": true, "repo": "sample-repo"}},
+		{Tool: "gh_release_download", Args: map[string]any{"tag": "sample-tag", "archive": "tar.gz", "clobber": true, "dir": "sample-dir", "output": "sample-output", "pattern": []any{"sample-pattern"}, "skip_existing": true, "repo": "sample-repo"}},
+		{Tool: "gh_release_upload", Args: map[string]any{"tag": "sample-tag", "assets": []any{"sample-assets"}, "clobber": true, "repo": "sample-repo"}},
+		{Tool: "gh_release_edit", Args: map[string]any{"tag": "sample-tag", "draft": true, "latest": true, "notes": "sample-notes", "notes_file": "sample-notes-file", "prerelease": true, "tag_name": "sample-tag-name", "target": "sample-target", "title": "sample-title", "discussion_category": "sample-discussion-category", "verify_tag": true, "repo": "sample-repo"}},
+	})
+}
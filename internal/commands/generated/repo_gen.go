@@ -2,9 +2,12 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -28,14 +31,39 @@ type RepoCreateArgs struct {
 	AddReadme     bool   `json:"add_readme,omitempty" jsonschema:"Add a README file to the new repository"`
 
 	Name string `json:"name,omitempty" jsonschema:"Name of the repository (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// repoCreateInputSpec holds the argument constraints for gh repo create
+var repoCreateInputSpec = toolkit.InputSpec{}
+
 // RegisterRepoCreateTool registers the gh repo create tool
-func RegisterRepoCreateTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRepoCreateTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_repo_create",
 		Description: "Create a new repository",
+		InputSchema: toolkit.InputSchema[RepoCreateArgs](repoCreateInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Create Repository",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoCreateArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo create", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo create", nil, err)), nil, nil
+		}
+
 		cmd := []string{"repo", "create"}
 
 		// Add positional argument: name
@@ -107,16 +135,23 @@ func RegisterRepoCreateTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--add-readme")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_create", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh repo create failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh repo create", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -130,19 +165,132 @@ type RepoListArgs struct {
 	NoArchived bool     `json:"no_archived,omitempty" jsonschema:"Omit archived repositories"`
 	Topic      string   `json:"topic,omitempty" jsonschema:"Filter by topic"`
 	Visibility string   `json:"visibility,omitempty" jsonschema:"Filter by visibility"`
-	Json       []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json       []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: nameWithOwner,description,visibility,isFork,isArchived,primaryLanguage,stargazerCount,updatedAt,url)"`
 	Jq         string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template   string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
+	Format     string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
 
 	Owner string `json:"owner,omitempty" jsonschema:"Owner (user or organization) (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// repoListInputSpec holds the argument constraints for gh repo list
+var repoListInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"visibility": {Enum: []string{"public", "private", "internal"}},
+		"format":     {Enum: []string{"json", "text"}, Default: "json"},
+	},
 }
 
+// repoListOutputSpec describes the --json output of gh repo list
+var repoListOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "archivedAt", Type: "string", Description: "When the repository was archived", Nullable: true},
+		{Name: "assignableUsers", Type: "array", Description: "Users that can be assigned"},
+		{Name: "codeOfConduct", Type: "object", Description: "Code of conduct", Nullable: true},
+		{Name: "contactLinks", Type: "array", Description: "Issue template contact links"},
+		{Name: "createdAt", Type: "string", Description: "When the repository was created"},
+		{Name: "defaultBranchRef", Type: "object", Description: "Default branch"},
+		{Name: "deleteBranchOnMerge", Type: "boolean", Description: "Whether head branches are deleted on merge"},
+		{Name: "description", Type: "string", Description: "Repository description"},
+		{Name: "diskUsage", Type: "integer", Description: "Disk usage in kilobytes"},
+		{Name: "forkCount", Type: "integer", Description: "Number of forks"},
+		{Name: "fundingLinks", Type: "array", Description: "Funding links"},
+		{Name: "hasDiscussionsEnabled", Type: "boolean", Description: "Whether discussions are enabled"},
+		{Name: "hasIssuesEnabled", Type: "boolean", Description: "Whether issues are enabled"},
+		{Name: "hasProjectsEnabled", Type: "boolean", Description: "Whether projects are enabled"},
+		{Name: "hasWikiEnabled", Type: "boolean", Description: "Whether the wiki is enabled"},
+		{Name: "homepageUrl", Type: "string", Description: "Homepage URL"},
+		{Name: "id", Type: "string", Description: "Node ID"},
+		{Name: "isArchived", Type: "boolean", Description: "Whether the repository is archived"},
+		{Name: "isBlankIssuesEnabled", Type: "boolean", Description: "Whether blank issues are allowed"},
+		{Name: "isEmpty", Type: "boolean", Description: "Whether the repository is empty"},
+		{Name: "isFork", Type: "boolean", Description: "Whether the repository is a fork"},
+		{Name: "isInOrganization", Type: "boolean", Description: "Whether the repository is owned by an organization"},
+		{Name: "isMirror", Type: "boolean", Description: "Whether the repository is a mirror"},
+		{Name: "isPrivate", Type: "boolean", Description: "Whether the repository is private"},
+		{Name: "isSecurityPolicyEnabled", Type: "boolean", Description: "Whether a security policy is enabled"},
+		{Name: "isTemplate", Type: "boolean", Description: "Whether the repository is a template"},
+		{Name: "isUserConfigurationRepository", Type: "boolean", Description: "Whether this is the owner's profile repository"},
+		{Name: "issueTemplates", Type: "array", Description: "Issue templates"},
+		{Name: "issues", Type: "object", Description: "Issue counts"},
+		{Name: "labels", Type: "array", Description: "Labels"},
+		{Name: "languages", Type: "array", Description: "Languages"},
+		{Name: "latestRelease", Type: "object", Description: "Latest release", Nullable: true},
+		{Name: "licenseInfo", Type: "object", Description: "License", Nullable: true},
+		{Name: "mentionableUsers", Type: "array", Description: "Users that can be mentioned"},
+		{Name: "mergeCommitAllowed", Type: "boolean", Description: "Whether merge commits are allowed"},
+		{Name: "milestones", Type: "array", Description: "Milestones"},
+		{Name: "mirrorUrl", Type: "string", Description: "Mirror source URL", Nullable: true},
+		{Name: "name", Type: "string", Description: "Repository name"},
+		{Name: "nameWithOwner", Type: "string", Description: "Repository name in OWNER/REPO format"},
+		{Name: "openGraphImageUrl", Type: "string", Description: "Social preview image URL"},
+		{Name: "owner", Type: "object", Description: "Repository owner"},
+		{Name: "parent", Type: "object", Description: "Parent repository of a fork", Nullable: true},
+		{Name: "primaryLanguage", Type: "object", Description: "Primary language", Nullable: true},
+		{Name: "projects", Type: "array", Description: "Classic projects"},
+		{Name: "projectsV2", Type: "object", Description: "Projects"},
+		{Name: "pullRequestTemplates", Type: "array", Description: "Pull request templates"},
+		{Name: "pullRequests", Type: "object", Description: "Pull request counts"},
+		{Name: "pushedAt", Type: "string", Description: "When the repository was last pushed to"},
+		{Name: "rebaseMergeAllowed", Type: "boolean", Description: "Whether rebase merging is allowed"},
+		{Name: "repositoryTopics", Type: "array", Description: "Topics"},
+		{Name: "securityPolicyUrl", Type: "string", Description: "Security policy URL"},
+		{Name: "squashMergeAllowed", Type: "boolean", Description: "Whether squash merging is allowed"},
+		{Name: "sshUrl", Type: "string", Description: "SSH clone URL"},
+		{Name: "stargazerCount", Type: "integer", Description: "Number of stars"},
+		{Name: "templateRepository", Type: "object", Description: "Template the repository was created from", Nullable: true},
+		{Name: "updatedAt", Type: "string", Description: "When the repository was last updated"},
+		{Name: "url", Type: "string", Description: "Repository URL"},
+		{Name: "usesCustomOpenGraphImage", Type: "boolean", Description: "Whether a custom social preview is set"},
+		{Name: "viewerCanAdminister", Type: "boolean", Description: "Whether the viewer can administer the repository"},
+		{Name: "viewerDefaultCommitEmail", Type: "string", Description: "Viewer's default commit email"},
+		{Name: "viewerDefaultMergeMethod", Type: "string", Description: "Viewer's default merge method"},
+		{Name: "viewerHasStarred", Type: "boolean", Description: "Whether the viewer has starred the repository"},
+		{Name: "viewerPermission", Type: "string", Description: "Viewer's permission"},
+		{Name: "viewerPossibleCommitEmails", Type: "array", Description: "Viewer's possible commit emails"},
+		{Name: "viewerSubscription", Type: "string", Description: "Viewer's subscription"},
+		{Name: "visibility", Type: "string", Description: "Repository visibility"},
+		{Name: "watchers", Type: "object", Description: "Watcher count"},
+	},
+}
+
+// repoListDefaultJSONFields are requested when no json fields are given
+var repoListDefaultJSONFields = []string{"nameWithOwner", "description", "visibility", "isFork", "isArchived", "primaryLanguage", "stargazerCount", "updatedAt", "url"}
+
 // RegisterRepoListTool registers the gh repo list tool
-func RegisterRepoListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRepoListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_repo_list",
-		Description: "List repositories owned by user or organization",
+		Name:         "gh_repo_list",
+		Description:  "List repositories owned by user or organization",
+		InputSchema:  toolkit.InputSchema[RepoListArgs](repoListInputSpec),
+		OutputSchema: toolkit.OutputSchema(repoListOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Repositories",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo list", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" {
+			args.Json = repoListDefaultJSONFields
+		}
+
 		cmd := []string{"repo", "list"}
 
 		// Add positional argument: owner
@@ -194,36 +342,154 @@ func RegisterRepoListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--template", args.Template)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh repo list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh repo list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
 // RepoViewArgs defines parameters for gh repo view
 type RepoViewArgs struct {
 	Branch   string   `json:"branch,omitempty" jsonschema:"View a specific branch of the repository"`
-	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: nameWithOwner,description,visibility,defaultBranchRef,isFork,isArchived,primaryLanguage,stargazerCount,forkCount,homepageUrl,url)"`
 	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Web      bool     `json:"web,omitempty" jsonschema:"Open repository in the browser"`
+	Format   string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
 
 	Repository string `json:"repository,omitempty" jsonschema:"Repository to view (OWNER/REPO or URL) (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// repoViewInputSpec holds the argument constraints for gh repo view
+var repoViewInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
+}
+
+// repoViewOutputSpec describes the --json output of gh repo view
+var repoViewOutputSpec = toolkit.OutputSpec{
+	Fields: []toolkit.OutputField{
+		{Name: "archivedAt", Type: "string", Description: "When the repository was archived", Nullable: true},
+		{Name: "assignableUsers", Type: "array", Description: "Users that can be assigned"},
+		{Name: "codeOfConduct", Type: "object", Description: "Code of conduct", Nullable: true},
+		{Name: "contactLinks", Type: "array", Description: "Issue template contact links"},
+		{Name: "createdAt", Type: "string", Description: "When the repository was created"},
+		{Name: "defaultBranchRef", Type: "object", Description: "Default branch"},
+		{Name: "deleteBranchOnMerge", Type: "boolean", Description: "Whether head branches are deleted on merge"},
+		{Name: "description", Type: "string", Description: "Repository description"},
+		{Name: "diskUsage", Type: "integer", Description: "Disk usage in kilobytes"},
+		{Name: "forkCount", Type: "integer", Description: "Number of forks"},
+		{Name: "fundingLinks", Type: "array", Description: "Funding links"},
+		{Name: "hasDiscussionsEnabled", Type: "boolean", Description: "Whether discussions are enabled"},
+		{Name: "hasIssuesEnabled", Type: "boolean", Description: "Whether issues are enabled"},
+		{Name: "hasProjectsEnabled", Type: "boolean", Description: "Whether projects are enabled"},
+		{Name: "hasWikiEnabled", Type: "boolean", Description: "Whether the wiki is enabled"},
+		{Name: "homepageUrl", Type: "string", Description: "Homepage URL"},
+		{Name: "id", Type: "string", Description: "Node ID"},
+		{Name: "isArchived", Type: "boolean", Description: "Whether the repository is archived"},
+		{Name: "isBlankIssuesEnabled", Type: "boolean", Description: "Whether blank issues are allowed"},
+		{Name: "isEmpty", Type: "boolean", Description: "Whether the repository is empty"},
+		{Name: "isFork", Type: "boolean", Description: "Whether the repository is a fork"},
+		{Name: "isInOrganization", Type: "boolean", Description: "Whether the repository is owned by an organization"},
+		{Name: "isMirror", Type: "boolean", Description: "Whether the repository is a mirror"},
+		{Name: "isPrivate", Type: "boolean", Description: "Whether the repository is private"},
+		{Name: "isSecurityPolicyEnabled", Type: "boolean", Description: "Whether a security policy is enabled"},
+		{Name: "isTemplate", Type: "boolean", Description: "Whether the repository is a template"},
+		{Name: "isUserConfigurationRepository", Type: "boolean", Description: "Whether this is the owner's profile repository"},
+		{Name: "issueTemplates", Type: "array", Description: "Issue templates"},
+		{Name: "issues", Type: "object", Description: "Issue counts"},
+		{Name: "labels", Type: "array", Description: "Labels"},
+		{Name: "languages", Type: "array", Description: "Languages"},
+		{Name: "latestRelease", Type: "object", Description: "Latest release", Nullable: true},
+		{Name: "licenseInfo", Type: "object", Description: "License", Nullable: true},
+		{Name: "mentionableUsers", Type: "array", Description: "Users that can be mentioned"},
+		{Name: "mergeCommitAllowed", Type: "boolean", Description: "Whether merge commits are allowed"},
+		{Name: "milestones", Type: "array", Description: "Milestones"},
+		{Name: "mirrorUrl", Type: "string", Description: "Mirror source URL", Nullable: true},
+		{Name: "name", Type: "string", Description: "Repository name"},
+		{Name: "nameWithOwner", Type: "string", Description: "Repository name in OWNER/REPO format"},
+		{Name: "openGraphImageUrl", Type: "string", Description: "Social preview image URL"},
+		{Name: "owner", Type: "object", Description: "Repository owner"},
+		{Name: "parent", Type: "object", Description: "Parent repository of a fork", Nullable: true},
+		{Name: "primaryLanguage", Type: "object", Description: "Primary language", Nullable: true},
+		{Name: "projects", Type: "array", Description: "Classic projects"},
+		{Name: "projectsV2", Type: "object", Description: "Projects"},
+		{Name: "pullRequestTemplates", Type: "array", Description: "Pull request templates"},
+		{Name: "pullRequests", Type: "object", Description: "Pull request counts"},
+		{Name: "pushedAt", Type: "string", Description: "When the repository was last pushed to"},
+		{Name: "rebaseMergeAllowed", Type: "boolean", Description: "Whether rebase merging is allowed"},
+		{Name: "repositoryTopics", Type: "array", Description: "Topics"},
+		{Name: "securityPolicyUrl", Type: "string", Description: "Security policy URL"},
+		{Name: "squashMergeAllowed", Type: "boolean", Description: "Whether squash merging is allowed"},
+		{Name: "sshUrl", Type: "string", Description: "SSH clone URL"},
+		{Name: "stargazerCount", Type: "integer", Description: "Number of stars"},
+		{Name: "templateRepository", Type: "object", Description: "Template the repository was created from", Nullable: true},
+		{Name: "updatedAt", Type: "string", Description: "When the repository was last updated"},
+		{Name: "url", Type: "string", Description: "Repository URL"},
+		{Name: "usesCustomOpenGraphImage", Type: "boolean", Description: "Whether a custom social preview is set"},
+		{Name: "viewerCanAdminister", Type: "boolean", Description: "Whether the viewer can administer the repository"},
+		{Name: "viewerDefaultCommitEmail", Type: "string", Description: "Viewer's default commit email"},
+		{Name: "viewerDefaultMergeMethod", Type: "string", Description: "Viewer's default merge method"},
+		{Name: "viewerHasStarred", Type: "boolean", Description: "Whether the viewer has starred the repository"},
+		{Name: "viewerPermission", Type: "string", Description: "Viewer's permission"},
+		{Name: "viewerPossibleCommitEmails", Type: "array", Description: "Viewer's possible commit emails"},
+		{Name: "viewerSubscription", Type: "string", Description: "Viewer's subscription"},
+		{Name: "visibility", Type: "string", Description: "Repository visibility"},
+		{Name: "watchers", Type: "object", Description: "Watcher count"},
+	},
+}
+
+// repoViewDefaultJSONFields are requested when no json fields are given
+var repoViewDefaultJSONFields = []string{"nameWithOwner", "description", "visibility", "defaultBranchRef", "isFork", "isArchived", "primaryLanguage", "stargazerCount", "forkCount", "homepageUrl", "url"}
+
 // RegisterRepoViewTool registers the gh repo view tool
-func RegisterRepoViewTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRepoViewTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_repo_view",
-		Description: "View a repository",
+		Name:         "gh_repo_view",
+		Description:  "View a repository",
+		InputSchema:  toolkit.InputSchema[RepoViewArgs](repoViewInputSpec),
+		OutputSchema: toolkit.OutputSchema(repoViewOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "View Repository",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoViewArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo view", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo view", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" && !args.Web {
+			args.Json = repoViewDefaultJSONFields
+		}
+
 		cmd := []string{"repo", "view"}
 
 		// Add positional argument: repository
@@ -251,16 +517,23 @@ func RegisterRepoViewTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--web")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_view", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh repo view failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh repo view", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, false)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -271,14 +544,44 @@ type RepoCloneArgs struct {
 
 	Repository string `json:"repository,omitempty" jsonschema:"Repository to clone (OWNER/REPO or URL) (positional argument)"`
 	Directory  string `json:"directory,omitempty" jsonschema:"Directory to clone into (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// repoCloneInputSpec holds the argument constraints for gh repo clone
+var repoCloneInputSpec = toolkit.InputSpec{
+	Required: []string{"repository"},
 }
 
 // RegisterRepoCloneTool registers the gh repo clone tool
-func RegisterRepoCloneTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRepoCloneTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_repo_clone",
 		Description: "Clone a repository locally",
+		InputSchema: toolkit.InputSchema[RepoCloneArgs](repoCloneInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Clone Repository",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoCloneArgs) (*mcp.CallToolResult, any, error) {
+		if err := repoCloneInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh repo clone", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo clone", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo clone", nil, err)), nil, nil
+		}
+
 		cmd := []string{"repo", "clone"}
 
 		// Add positional argument: repository
@@ -299,16 +602,24 @@ func RegisterRepoCloneTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--recurse-submodules")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_clone", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 1800),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+			OnLine:      toolkit.Progress(ctx, req),
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh repo clone failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh repo clone", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -322,14 +633,39 @@ type RepoForkArgs struct {
 	RemoteName        string `json:"remote_name,omitempty" jsonschema:"Specify the remote name"`
 
 	Repository string `json:"repository,omitempty" jsonschema:"Repository to fork (OWNER/REPO or URL) (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// repoForkInputSpec holds the argument constraints for gh repo fork
+var repoForkInputSpec = toolkit.InputSpec{}
+
 // RegisterRepoForkTool registers the gh repo fork tool
-func RegisterRepoForkTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRepoForkTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_repo_fork",
 		Description: "Create a fork of a repository",
+		InputSchema: toolkit.InputSchema[RepoForkArgs](repoForkInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Fork Repository",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoForkArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo fork", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo fork", nil, err)), nil, nil
+		}
+
 		cmd := []string{"repo", "fork"}
 
 		// Add positional argument: repository
@@ -361,16 +697,23 @@ func RegisterRepoForkTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--remote-name", args.RemoteName)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_fork", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh repo fork failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh repo fork", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -379,14 +722,45 @@ type RepoDeleteArgs struct {
 	Yes bool `json:"yes,omitempty" jsonschema:"Skip the confirmation prompt"`
 
 	Repository string `json:"repository,omitempty" jsonschema:"Repository to delete (OWNER/REPO) (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// repoDeleteInputSpec holds the argument constraints for gh repo delete
+var repoDeleteInputSpec = toolkit.InputSpec{
+	Required: []string{"repository"},
 }
 
 // RegisterRepoDeleteTool registers the gh repo delete tool
-func RegisterRepoDeleteTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRepoDeleteTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_repo_delete",
 		Description: "Delete a repository",
+		InputSchema: toolkit.InputSchema[RepoDeleteArgs](repoDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete Repository",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoDeleteArgs) (*mcp.CallToolResult, any, error) {
+		if err := repoDeleteInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh repo delete", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo delete", nil, err)), nil, nil
+		}
+
 		cmd := []string{"repo", "delete"}
 
 		// Add positional argument: repository
@@ -398,16 +772,29 @@ func RegisterRepoDeleteTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--yes")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh repo delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_repo_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh repo delete failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh repo delete", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -417,14 +804,39 @@ type RepoArchiveArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	Repository string `json:"repository,omitempty" jsonschema:"Repository to archive (OWNER/REPO) (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// repoArchiveInputSpec holds the argument constraints for gh repo archive
+var repoArchiveInputSpec = toolkit.InputSpec{}
+
 // RegisterRepoArchiveTool registers the gh repo archive tool
-func RegisterRepoArchiveTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRepoArchiveTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_repo_archive",
 		Description: "Archive a repository",
+		InputSchema: toolkit.InputSchema[RepoArchiveArgs](repoArchiveInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Archive Repository",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoArchiveArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo archive", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo archive", nil, err)), nil, nil
+		}
+
 		cmd := []string{"repo", "archive"}
 
 		// Add positional argument: repository
@@ -440,16 +852,23 @@ func RegisterRepoArchiveTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_archive", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh repo archive failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh repo archive", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -459,14 +878,39 @@ type RepoUnarchiveArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	Repository string `json:"repository,omitempty" jsonschema:"Repository to unarchive (OWNER/REPO) (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// repoUnarchiveInputSpec holds the argument constraints for gh repo unarchive
+var repoUnarchiveInputSpec = toolkit.InputSpec{}
+
 // RegisterRepoUnarchiveTool registers the gh repo unarchive tool
-func RegisterRepoUnarchiveTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRepoUnarchiveTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_repo_unarchive",
 		Description: "Unarchive a repository",
+		InputSchema: toolkit.InputSchema[RepoUnarchiveArgs](repoUnarchiveInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Unarchive Repository",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoUnarchiveArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo unarchive", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo unarchive", nil, err)), nil, nil
+		}
+
 		cmd := []string{"repo", "unarchive"}
 
 		// Add positional argument: repository
@@ -482,16 +926,23 @@ func RegisterRepoUnarchiveTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_unarchive", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh repo unarchive failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh repo unarchive", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -499,32 +950,61 @@ func RegisterRepoUnarchiveTool(server *mcp.Server, exec *executor.Executor) {
 type RepoEditArgs struct {
 	AddTopic            []string `json:"add_topic,omitempty" jsonschema:"Add repository topic"`
 	RemoveTopic         []string `json:"remove_topic,omitempty" jsonschema:"Remove repository topic"`
-	AllowForking        bool     `json:"allow_forking,omitempty" jsonschema:"Allow forking"`
+	AllowForking        *bool    `json:"allow_forking,omitempty" jsonschema:"Allow forking"`
 	DefaultBranch       string   `json:"default_branch,omitempty" jsonschema:"Set the default branch name"`
-	DeleteBranchOnMerge bool     `json:"delete_branch_on_merge,omitempty" jsonschema:"Delete head branch on merge"`
+	DeleteBranchOnMerge *bool    `json:"delete_branch_on_merge,omitempty" jsonschema:"Delete head branch on merge"`
 	Description         string   `json:"description,omitempty" jsonschema:"Repository description"`
-	EnableAutoMerge     bool     `json:"enable_auto_merge,omitempty" jsonschema:"Enable auto-merge"`
-	EnableDiscussions   bool     `json:"enable_discussions,omitempty" jsonschema:"Enable discussions"`
-	EnableIssues        bool     `json:"enable_issues,omitempty" jsonschema:"Enable issues"`
-	EnableMergeCommit   bool     `json:"enable_merge_commit,omitempty" jsonschema:"Enable merge commits"`
-	EnableProjects      bool     `json:"enable_projects,omitempty" jsonschema:"Enable projects"`
-	EnableRebaseMerge   bool     `json:"enable_rebase_merge,omitempty" jsonschema:"Enable rebase merging"`
-	EnableSquashMerge   bool     `json:"enable_squash_merge,omitempty" jsonschema:"Enable squash merging"`
-	EnableWiki          bool     `json:"enable_wiki,omitempty" jsonschema:"Enable wiki"`
+	EnableAutoMerge     *bool    `json:"enable_auto_merge,omitempty" jsonschema:"Enable auto-merge"`
+	EnableDiscussions   *bool    `json:"enable_discussions,omitempty" jsonschema:"Enable discussions"`
+	EnableIssues        *bool    `json:"enable_issues,omitempty" jsonschema:"Enable issues"`
+	EnableMergeCommit   *bool    `json:"enable_merge_commit,omitempty" jsonschema:"Enable merge commits"`
+	EnableProjects      *bool    `json:"enable_projects,omitempty" jsonschema:"Enable projects"`
+	EnableRebaseMerge   *bool    `json:"enable_rebase_merge,omitempty" jsonschema:"Enable rebase merging"`
+	EnableSquashMerge   *bool    `json:"enable_squash_merge,omitempty" jsonschema:"Enable squash merging"`
+	EnableWiki          *bool    `json:"enable_wiki,omitempty" jsonschema:"Enable wiki"`
 	Homepage            string   `json:"homepage,omitempty" jsonschema:"Repository home page URL"`
-	Template            bool     `json:"template,omitempty" jsonschema:"Make the repository a template"`
+	Template            *bool    `json:"template,omitempty" jsonschema:"Make the repository a template"`
 	Visibility          string   `json:"visibility,omitempty" jsonschema:"Repository visibility"`
 	Repo                string   `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	Repository string `json:"repository,omitempty" jsonschema:"Repository to edit (OWNER/REPO) (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// repoEditInputSpec holds the argument constraints for gh repo edit
+var repoEditInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"visibility": {Enum: []string{"public", "private", "internal"}},
+	},
 }
 
 // RegisterRepoEditTool registers the gh repo edit tool
-func RegisterRepoEditTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRepoEditTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_repo_edit",
 		Description: "Edit repository settings",
+		InputSchema: toolkit.InputSchema[RepoEditArgs](repoEditInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Edit Repository Settings",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoEditArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo edit", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo edit", nil, err)), nil, nil
+		}
+
 		cmd := []string{"repo", "edit"}
 
 		// Add positional argument: repository
@@ -540,60 +1020,60 @@ func RegisterRepoEditTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--remove-topic", v)
 		}
 
-		if args.AllowForking {
-			cmd = append(cmd, "--allow-forking")
+		if args.AllowForking != nil {
+			cmd = append(cmd, fmt.Sprintf("--allow-forking=%t", *args.AllowForking))
 		}
 
 		if args.DefaultBranch != "" {
 			cmd = append(cmd, "--default-branch", args.DefaultBranch)
 		}
 
-		if args.DeleteBranchOnMerge {
-			cmd = append(cmd, "--delete-branch-on-merge")
+		if args.DeleteBranchOnMerge != nil {
+			cmd = append(cmd, fmt.Sprintf("--delete-branch-on-merge=%t", *args.DeleteBranchOnMerge))
 		}
 
 		if args.Description != "" {
 			cmd = append(cmd, "--description", args.Description)
 		}
 
-		if args.EnableAutoMerge {
-			cmd = append(cmd, "--enable-auto-merge")
+		if args.EnableAutoMerge != nil {
+			cmd = append(cmd, fmt.Sprintf("--enable-auto-merge=%t", *args.EnableAutoMerge))
 		}
 
-		if args.EnableDiscussions {
-			cmd = append(cmd, "--enable-discussions")
+		if args.EnableDiscussions != nil {
+			cmd = append(cmd, fmt.Sprintf("--enable-discussions=%t", *args.EnableDiscussions))
 		}
 
-		if args.EnableIssues {
-			cmd = append(cmd, "--enable-issues")
+		if args.EnableIssues != nil {
+			cmd = append(cmd, fmt.Sprintf("--enable-issues=%t", *args.EnableIssues))
 		}
 
-		if args.EnableMergeCommit {
-			cmd = append(cmd, "--enable-merge-commit")
+		if args.EnableMergeCommit != nil {
+			cmd = append(cmd, fmt.Sprintf("--enable-merge-commit=%t", *args.EnableMergeCommit))
 		}
 
-		if args.EnableProjects {
-			cmd = append(cmd, "--enable-projects")
+		if args.EnableProjects != nil {
+			cmd = append(cmd, fmt.Sprintf("--enable-projects=%t", *args.EnableProjects))
 		}
 
-		if args.EnableRebaseMerge {
-			cmd = append(cmd, "--enable-rebase-merge")
+		if args.EnableRebaseMerge != nil {
+			cmd = append(cmd, fmt.Sprintf("--enable-rebase-merge=%t", *args.EnableRebaseMerge))
 		}
 
-		if args.EnableSquashMerge {
-			cmd = append(cmd, "--enable-squash-merge")
+		if args.EnableSquashMerge != nil {
+			cmd = append(cmd, fmt.Sprintf("--enable-squash-merge=%t", *args.EnableSquashMerge))
 		}
 
-		if args.EnableWiki {
-			cmd = append(cmd, "--enable-wiki")
+		if args.EnableWiki != nil {
+			cmd = append(cmd, fmt.Sprintf("--enable-wiki=%t", *args.EnableWiki))
 		}
 
 		if args.Homepage != "" {
 			cmd = append(cmd, "--homepage", args.Homepage)
 		}
 
-		if args.Template {
-			cmd = append(cmd, "--template")
+		if args.Template != nil {
+			cmd = append(cmd, fmt.Sprintf("--template=%t", *args.Template))
 		}
 
 		if args.Visibility != "" {
@@ -604,16 +1084,23 @@ func RegisterRepoEditTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_edit", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh repo edit failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh repo edit", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -623,14 +1110,44 @@ type RepoRenameArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	NewName string `json:"new_name,omitempty" jsonschema:"New name for the repository (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// repoRenameInputSpec holds the argument constraints for gh repo rename
+var repoRenameInputSpec = toolkit.InputSpec{
+	Required: []string{"new_name"},
 }
 
 // RegisterRepoRenameTool registers the gh repo rename tool
-func RegisterRepoRenameTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRepoRenameTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_repo_rename",
 		Description: "Rename a repository",
+		InputSchema: toolkit.InputSchema[RepoRenameArgs](repoRenameInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Rename Repository",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoRenameArgs) (*mcp.CallToolResult, any, error) {
+		if err := repoRenameInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh repo rename", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo rename", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo rename", nil, err)), nil, nil
+		}
+
 		cmd := []string{"repo", "rename"}
 
 		// Add positional argument: new_name
@@ -646,16 +1163,23 @@ func RegisterRepoRenameTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_rename", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh repo rename failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh repo rename", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -665,14 +1189,39 @@ type RepoSyncArgs struct {
 	Branch string `json:"branch,omitempty" jsonschema:"Branch to sync"`
 	Force  bool   `json:"force,omitempty" jsonschema:"Hard reset if source diverged"`
 	Repo   string `json:"repo,omitempty" jsonschema:"Select repository"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// repoSyncInputSpec holds the argument constraints for gh repo sync
+var repoSyncInputSpec = toolkit.InputSpec{}
+
 // RegisterRepoSyncTool registers the gh repo sync tool
-func RegisterRepoSyncTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRepoSyncTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_repo_sync",
 		Description: "Sync a repository",
+		InputSchema: toolkit.InputSchema[RepoSyncArgs](repoSyncInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Sync Repository",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoSyncArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo sync", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo sync", nil, err)), nil, nil
+		}
+
 		cmd := []string{"repo", "sync"}
 
 		if args.Source != "" {
@@ -691,15 +1240,922 @@ func RegisterRepoSyncTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_sync", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh repo sync failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh repo sync", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
+	})
+}
+
+// RepoDeployKeyListArgs defines parameters for gh repo deploy-key list
+type RepoDeployKeyListArgs struct {
+	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
+	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
+	Repo     string   `json:"repo,omitempty" jsonschema:"Select repository"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// repoDeployKeyListInputSpec holds the argument constraints for gh repo deploy-key list
+var repoDeployKeyListInputSpec = toolkit.InputSpec{}
+
+// repoDeployKeyListOutputSpec describes the --json output of gh repo deploy-key list
+var repoDeployKeyListOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "createdAt", Type: "string", Description: "When the key was added"},
+		{Name: "id", Type: "integer", Description: "Deploy key ID"},
+		{Name: "key", Type: "string", Description: "Public key"},
+		{Name: "readOnly", Type: "boolean", Description: "Whether the key is read-only"},
+		{Name: "title", Type: "string", Description: "Key title"},
+	},
+}
+
+// RegisterRepoDeployKeyListTool registers the gh repo deploy-key list tool
+func RegisterRepoDeployKeyListTool(server *mcp.Server, exec executor.Runner) {
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         "gh_repo_deploy_key_list",
+		Description:  "List deploy keys in a repository",
+		InputSchema:  toolkit.InputSchema[RepoDeployKeyListArgs](repoDeployKeyListInputSpec),
+		OutputSchema: toolkit.OutputSchema(repoDeployKeyListOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Deploy Keys",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoDeployKeyListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo deploy-key list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo deploy-key list", nil, err)), nil, nil
+		}
+
+		cmd := []string{"repo", "deploy-key", "list"}
+
+		for _, v := range args.Json {
+			cmd = append(cmd, "--json", v)
+		}
+
+		if args.Jq != "" {
+			cmd = append(cmd, "--jq", args.Jq)
+		}
+
+		if args.Template != "" {
+			cmd = append(cmd, "--template", args.Template)
+		}
+
+		if args.Repo != "" {
+			cmd = append(cmd, "--repo", args.Repo)
+		}
+
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_deploy_key_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo deploy-key list", result, err)), nil, nil
+		}
+
+		if len(args.Json) > 0 && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
+
+// RepoDeployKeyAddArgs defines parameters for gh repo deploy-key add
+type RepoDeployKeyAddArgs struct {
+	AllowWrite bool   `json:"allow_write,omitempty" jsonschema:"Allow write access for the key"`
+	Title      string `json:"title,omitempty" jsonschema:"Title of the new key"`
+	Repo       string `json:"repo,omitempty" jsonschema:"Select repository"`
+
+	KeyFile string `json:"key_file,omitempty" jsonschema:"Path to the public key file, or - to read from standard input (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// repoDeployKeyAddInputSpec holds the argument constraints for gh repo deploy-key add
+var repoDeployKeyAddInputSpec = toolkit.InputSpec{
+	Required: []string{"key_file"},
+}
+
+// RegisterRepoDeployKeyAddTool registers the gh repo deploy-key add tool
+func RegisterRepoDeployKeyAddTool(server *mcp.Server, exec executor.Runner) {
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "gh_repo_deploy_key_add",
+		Description: "Add a deploy key to a repository",
+		InputSchema: toolkit.InputSchema[RepoDeployKeyAddArgs](repoDeployKeyAddInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Add Deploy Key",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoDeployKeyAddArgs) (*mcp.CallToolResult, any, error) {
+		if err := repoDeployKeyAddInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh repo deploy-key add", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo deploy-key add", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo deploy-key add", nil, err)), nil, nil
+		}
+
+		cmd := []string{"repo", "deploy-key", "add"}
+
+		// Add positional argument: key_file
+		if args.KeyFile != "" {
+			cmd = append(cmd, args.KeyFile)
+		}
+
+		if args.AllowWrite {
+			cmd = append(cmd, "--allow-write")
+		}
+
+		if args.Title != "" {
+			cmd = append(cmd, "--title", args.Title)
+		}
+
+		if args.Repo != "" {
+			cmd = append(cmd, "--repo", args.Repo)
+		}
+
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_deploy_key_add", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo deploy-key add", result, err)), nil, nil
+		}
+
+		return defaults.Annotate(&mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: result.Stdout},
+			},
+		}), nil, nil
+	})
+}
+
+// RepoDeployKeyDeleteArgs defines parameters for gh repo deploy-key delete
+type RepoDeployKeyDeleteArgs struct {
+	Repo string `json:"repo,omitempty" jsonschema:"Select repository"`
+
+	KeyId string `json:"key_id,omitempty" jsonschema:"ID of the deploy key to delete (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// repoDeployKeyDeleteInputSpec holds the argument constraints for gh repo deploy-key delete
+var repoDeployKeyDeleteInputSpec = toolkit.InputSpec{
+	Required: []string{"key_id"},
+}
+
+// RegisterRepoDeployKeyDeleteTool registers the gh repo deploy-key delete tool
+func RegisterRepoDeployKeyDeleteTool(server *mcp.Server, exec executor.Runner) {
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "gh_repo_deploy_key_delete",
+		Description: "Delete a deploy key from a repository",
+		InputSchema: toolkit.InputSchema[RepoDeployKeyDeleteArgs](repoDeployKeyDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete Deploy Key",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoDeployKeyDeleteArgs) (*mcp.CallToolResult, any, error) {
+		if err := repoDeployKeyDeleteInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh repo deploy-key delete", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo deploy-key delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo deploy-key delete", nil, err)), nil, nil
+		}
+
+		cmd := []string{"repo", "deploy-key", "delete"}
+
+		// Add positional argument: key_id
+		if args.KeyId != "" {
+			cmd = append(cmd, args.KeyId)
+		}
+
+		if args.Repo != "" {
+			cmd = append(cmd, "--repo", args.Repo)
+		}
+
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh repo deploy-key delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_repo_deploy_key_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo deploy-key delete", result, err)), nil, nil
+		}
+
+		return defaults.Annotate(&mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: result.Stdout},
+			},
+		}), nil, nil
+	})
+}
+
+// RepoAutolinkListArgs defines parameters for gh repo autolink list
+type RepoAutolinkListArgs struct {
+	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
+	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
+	Web      bool     `json:"web,omitempty" jsonschema:"List autolinks in the web browser"`
+	Repo     string   `json:"repo,omitempty" jsonschema:"Select repository"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// repoAutolinkListInputSpec holds the argument constraints for gh repo autolink list
+var repoAutolinkListInputSpec = toolkit.InputSpec{}
+
+// repoAutolinkListOutputSpec describes the --json output of gh repo autolink list
+var repoAutolinkListOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "id", Type: "integer", Description: "Autolink ID"},
+		{Name: "isAlphanumeric", Type: "boolean", Description: "Whether the reference matches alphanumeric characters"},
+		{Name: "keyPrefix", Type: "string", Description: "Reference prefix"},
+		{Name: "urlTemplate", Type: "string", Description: "URL template with <num> placeholder"},
+	},
+}
+
+// RegisterRepoAutolinkListTool registers the gh repo autolink list tool
+func RegisterRepoAutolinkListTool(server *mcp.Server, exec executor.Runner) {
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         "gh_repo_autolink_list",
+		Description:  "List autolink references for a repository",
+		InputSchema:  toolkit.InputSchema[RepoAutolinkListArgs](repoAutolinkListInputSpec),
+		OutputSchema: toolkit.OutputSchema(repoAutolinkListOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Autolinks",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoAutolinkListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo autolink list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo autolink list", nil, err)), nil, nil
+		}
+
+		cmd := []string{"repo", "autolink", "list"}
+
+		for _, v := range args.Json {
+			cmd = append(cmd, "--json", v)
+		}
+
+		if args.Jq != "" {
+			cmd = append(cmd, "--jq", args.Jq)
+		}
+
+		if args.Template != "" {
+			cmd = append(cmd, "--template", args.Template)
+		}
+
+		if args.Web {
+			cmd = append(cmd, "--web")
+		}
+
+		if args.Repo != "" {
+			cmd = append(cmd, "--repo", args.Repo)
+		}
+
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_autolink_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo autolink list", result, err)), nil, nil
+		}
+
+		if len(args.Json) > 0 && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
+	})
+}
+
+// RepoAutolinkCreateArgs defines parameters for gh repo autolink create
+type RepoAutolinkCreateArgs struct {
+	Numeric bool   `json:"numeric,omitempty" jsonschema:"Mark autolink as numeric"`
+	Repo    string `json:"repo,omitempty" jsonschema:"Select repository"`
+
+	KeyPrefix   string `json:"key_prefix,omitempty" jsonschema:"Prefix that triggers the autolink, e.g. TICKET- (positional argument)"`
+	UrlTemplate string `json:"url_template,omitempty" jsonschema:"URL to link to, containing <num> for the reference number (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// repoAutolinkCreateInputSpec holds the argument constraints for gh repo autolink create
+var repoAutolinkCreateInputSpec = toolkit.InputSpec{
+	Required: []string{"key_prefix", "url_template"},
+}
+
+// RegisterRepoAutolinkCreateTool registers the gh repo autolink create tool
+func RegisterRepoAutolinkCreateTool(server *mcp.Server, exec executor.Runner) {
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "gh_repo_autolink_create",
+		Description: "Create a new autolink reference",
+		InputSchema: toolkit.InputSchema[RepoAutolinkCreateArgs](repoAutolinkCreateInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Create Autolink",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoAutolinkCreateArgs) (*mcp.CallToolResult, any, error) {
+		if err := repoAutolinkCreateInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh repo autolink create", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo autolink create", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo autolink create", nil, err)), nil, nil
+		}
+
+		cmd := []string{"repo", "autolink", "create"}
+
+		// Add positional argument: key_prefix
+		if args.KeyPrefix != "" {
+			cmd = append(cmd, args.KeyPrefix)
+		}
+
+		// Add positional argument: url_template
+		if args.UrlTemplate != "" {
+			cmd = append(cmd, args.UrlTemplate)
+		}
+
+		if args.Numeric {
+			cmd = append(cmd, "--numeric")
+		}
+
+		if args.Repo != "" {
+			cmd = append(cmd, "--repo", args.Repo)
+		}
+
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_autolink_create", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo autolink create", result, err)), nil, nil
+		}
+
+		return defaults.Annotate(&mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: result.Stdout},
+			},
+		}), nil, nil
+	})
+}
+
+// RepoAutolinkViewArgs defines parameters for gh repo autolink view
+type RepoAutolinkViewArgs struct {
+	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
+	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
+	Repo     string   `json:"repo,omitempty" jsonschema:"Select repository"`
+
+	Id string `json:"id,omitempty" jsonschema:"ID of the autolink reference (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// repoAutolinkViewInputSpec holds the argument constraints for gh repo autolink view
+var repoAutolinkViewInputSpec = toolkit.InputSpec{
+	Required: []string{"id"},
+}
+
+// repoAutolinkViewOutputSpec describes the --json output of gh repo autolink view
+var repoAutolinkViewOutputSpec = toolkit.OutputSpec{
+	Fields: []toolkit.OutputField{
+		{Name: "id", Type: "integer", Description: "Autolink ID"},
+		{Name: "isAlphanumeric", Type: "boolean", Description: "Whether the reference matches alphanumeric characters"},
+		{Name: "keyPrefix", Type: "string", Description: "Reference prefix"},
+		{Name: "urlTemplate", Type: "string", Description: "URL template with <num> placeholder"},
+	},
+}
+
+// RegisterRepoAutolinkViewTool registers the gh repo autolink view tool
+func RegisterRepoAutolinkViewTool(server *mcp.Server, exec executor.Runner) {
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         "gh_repo_autolink_view",
+		Description:  "View an autolink reference",
+		InputSchema:  toolkit.InputSchema[RepoAutolinkViewArgs](repoAutolinkViewInputSpec),
+		OutputSchema: toolkit.OutputSchema(repoAutolinkViewOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "View Autolink",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoAutolinkViewArgs) (*mcp.CallToolResult, any, error) {
+		if err := repoAutolinkViewInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh repo autolink view", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo autolink view", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo autolink view", nil, err)), nil, nil
+		}
+
+		cmd := []string{"repo", "autolink", "view"}
+
+		// Add positional argument: id
+		if args.Id != "" {
+			cmd = append(cmd, args.Id)
+		}
+
+		for _, v := range args.Json {
+			cmd = append(cmd, "--json", v)
+		}
+
+		if args.Jq != "" {
+			cmd = append(cmd, "--jq", args.Jq)
+		}
+
+		if args.Template != "" {
+			cmd = append(cmd, "--template", args.Template)
+		}
+
+		if args.Repo != "" {
+			cmd = append(cmd, "--repo", args.Repo)
+		}
+
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_autolink_view", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo autolink view", result, err)), nil, nil
+		}
+
+		if len(args.Json) > 0 && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, false)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
+	})
+}
+
+// RepoAutolinkDeleteArgs defines parameters for gh repo autolink delete
+type RepoAutolinkDeleteArgs struct {
+	Yes  bool   `json:"yes,omitempty" jsonschema:"Confirm deletion without prompting"`
+	Repo string `json:"repo,omitempty" jsonschema:"Select repository"`
+
+	Id string `json:"id,omitempty" jsonschema:"ID of the autolink reference (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// repoAutolinkDeleteInputSpec holds the argument constraints for gh repo autolink delete
+var repoAutolinkDeleteInputSpec = toolkit.InputSpec{
+	Required: []string{"id"},
+}
+
+// RegisterRepoAutolinkDeleteTool registers the gh repo autolink delete tool
+func RegisterRepoAutolinkDeleteTool(server *mcp.Server, exec executor.Runner) {
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "gh_repo_autolink_delete",
+		Description: "Delete an autolink reference",
+		InputSchema: toolkit.InputSchema[RepoAutolinkDeleteArgs](repoAutolinkDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete Autolink",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoAutolinkDeleteArgs) (*mcp.CallToolResult, any, error) {
+		if err := repoAutolinkDeleteInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh repo autolink delete", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo autolink delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo autolink delete", nil, err)), nil, nil
+		}
+
+		cmd := []string{"repo", "autolink", "delete"}
+
+		// Add positional argument: id
+		if args.Id != "" {
+			cmd = append(cmd, args.Id)
+		}
+
+		if args.Yes {
+			cmd = append(cmd, "--yes")
+		}
+
+		if args.Repo != "" {
+			cmd = append(cmd, "--repo", args.Repo)
+		}
+
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh repo autolink delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_repo_autolink_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo autolink delete", result, err)), nil, nil
+		}
+
+		return defaults.Annotate(&mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: result.Stdout},
+			},
+		}), nil, nil
+	})
+}
+
+// RepoGitignoreListArgs defines parameters for gh repo gitignore list
+type RepoGitignoreListArgs struct {
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// repoGitignoreListInputSpec holds the argument constraints for gh repo gitignore list
+var repoGitignoreListInputSpec = toolkit.InputSpec{}
+
+// RegisterRepoGitignoreListTool registers the gh repo gitignore list tool
+func RegisterRepoGitignoreListTool(server *mcp.Server, exec executor.Runner) {
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "gh_repo_gitignore_list",
+		Description: "List available repository gitignore templates",
+		InputSchema: toolkit.InputSchema[RepoGitignoreListArgs](repoGitignoreListInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Gitignore Templates",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoGitignoreListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo gitignore list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo gitignore list", nil, err)), nil, nil
+		}
+
+		cmd := []string{"repo", "gitignore", "list"}
+
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_gitignore_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo gitignore list", result, err)), nil, nil
+		}
+
+		return defaults.Annotate(&mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: result.Stdout},
+			},
+		}), nil, nil
+	})
+}
+
+// RepoGitignoreViewArgs defines parameters for gh repo gitignore view
+type RepoGitignoreViewArgs struct {
+	Template string `json:"template,omitempty" jsonschema:"Name of the gitignore template, e.g. Go (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// repoGitignoreViewInputSpec holds the argument constraints for gh repo gitignore view
+var repoGitignoreViewInputSpec = toolkit.InputSpec{
+	Required: []string{"template"},
+}
+
+// RegisterRepoGitignoreViewTool registers the gh repo gitignore view tool
+func RegisterRepoGitignoreViewTool(server *mcp.Server, exec executor.Runner) {
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "gh_repo_gitignore_view",
+		Description: "View an available repository gitignore template",
+		InputSchema: toolkit.InputSchema[RepoGitignoreViewArgs](repoGitignoreViewInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "View Gitignore Template",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoGitignoreViewArgs) (*mcp.CallToolResult, any, error) {
+		if err := repoGitignoreViewInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh repo gitignore view", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo gitignore view", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo gitignore view", nil, err)), nil, nil
+		}
+
+		cmd := []string{"repo", "gitignore", "view"}
+
+		// Add positional argument: template
+		if args.Template != "" {
+			cmd = append(cmd, args.Template)
+		}
+
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_gitignore_view", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo gitignore view", result, err)), nil, nil
+		}
+
+		return defaults.Annotate(&mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: result.Stdout},
+			},
+		}), nil, nil
+	})
+}
+
+// RepoLicenseListArgs defines parameters for gh repo license list
+type RepoLicenseListArgs struct {
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// repoLicenseListInputSpec holds the argument constraints for gh repo license list
+var repoLicenseListInputSpec = toolkit.InputSpec{}
+
+// RegisterRepoLicenseListTool registers the gh repo license list tool
+func RegisterRepoLicenseListTool(server *mcp.Server, exec executor.Runner) {
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "gh_repo_license_list",
+		Description: "List common repository licenses",
+		InputSchema: toolkit.InputSchema[RepoLicenseListArgs](repoLicenseListInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Licenses",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoLicenseListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo license list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo license list", nil, err)), nil, nil
+		}
+
+		cmd := []string{"repo", "license", "list"}
+
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_license_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo license list", result, err)), nil, nil
+		}
+
+		return defaults.Annotate(&mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: result.Stdout},
+			},
+		}), nil, nil
+	})
+}
+
+// RepoLicenseViewArgs defines parameters for gh repo license view
+type RepoLicenseViewArgs struct {
+	Web bool `json:"web,omitempty" jsonschema:"Open https://choosealicense.com/ in the browser"`
+
+	License string `json:"license,omitempty" jsonschema:"License key or SPDX ID, e.g. mit (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// repoLicenseViewInputSpec holds the argument constraints for gh repo license view
+var repoLicenseViewInputSpec = toolkit.InputSpec{
+	Required: []string{"license"},
+}
+
+// RegisterRepoLicenseViewTool registers the gh repo license view tool
+func RegisterRepoLicenseViewTool(server *mcp.Server, exec executor.Runner) {
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "gh_repo_license_view",
+		Description: "View a specific repository license",
+		InputSchema: toolkit.InputSchema[RepoLicenseViewArgs](repoLicenseViewInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "View License",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args RepoLicenseViewArgs) (*mcp.CallToolResult, any, error) {
+		if err := repoLicenseViewInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh repo license view", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh repo license view", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo license view", nil, err)), nil, nil
+		}
+
+		cmd := []string{"repo", "license", "view"}
+
+		// Add positional argument: license
+		if args.License != "" {
+			cmd = append(cmd, args.License)
+		}
+
+		if args.Web {
+			cmd = append(cmd, "--web")
+		}
+
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_repo_license_view", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh repo license view", result, err)), nil, nil
+		}
+
+		return defaults.Annotate(&mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: result.Stdout},
+			},
+		}), nil, nil
+	})
+}
+
+// RegisterRepoTools registers the gh repo tools
+func RegisterRepoTools(server *mcp.Server, exec executor.Runner) {
+	RegisterRepoCreateTool(server, exec)
+	RegisterRepoListTool(server, exec)
+	RegisterRepoViewTool(server, exec)
+	RegisterRepoCloneTool(server, exec)
+	RegisterRepoForkTool(server, exec)
+	RegisterRepoDeleteTool(server, exec)
+	RegisterRepoArchiveTool(server, exec)
+	RegisterRepoUnarchiveTool(server, exec)
+	RegisterRepoEditTool(server, exec)
+	RegisterRepoRenameTool(server, exec)
+	RegisterRepoSyncTool(server, exec)
+	RegisterRepoDeployKeyListTool(server, exec)
+	RegisterRepoDeployKeyAddTool(server, exec)
+	RegisterRepoDeployKeyDeleteTool(server, exec)
+	RegisterRepoAutolinkListTool(server, exec)
+	RegisterRepoAutolinkCreateTool(server, exec)
+	RegisterRepoAutolinkViewTool(server, exec)
+	RegisterRepoAutolinkDeleteTool(server, exec)
+	RegisterRepoGitignoreListTool(server, exec)
+	RegisterRepoGitignoreViewTool(server, exec)
+	RegisterRepoLicenseListTool(server, exec)
+	RegisterRepoLicenseViewTool(server, exec)
+}
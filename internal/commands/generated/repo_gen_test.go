@@ -0,0 +1,33 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestRepoArgv calls every gh repo tool with representative
+// arguments and compares the commands they run with testdata/repo.golden
+func TestRepoArgv(t *testing.T) {
+	checkArgvGolden(t, "repo", []argvCase{
+		{Tool: "gh_repo_create", Args: map[string]any{"name": "sample-name", "clone": true, "description": "sample-description", "homepage": "sample-homepage", "team": "sample-team", "template": "sample-template", "public": true, "private": true, "internal": true, "disable_issues": true, "disable_wiki": true, "gitignore": "sample-gitignore", "license": "sample-license", "push": true, "source": "sample-source", "remote": "sample-remote", "add_readme": true}},
+		{Tool: "gh_repo_list", Args: map[string]any{"owner": "sample-owner", "archived": true, "fork": true, "source": true, "language": "sample-language", "limit": 1, "no_archived": true, "topic": "sample-topic", "visibility": "public", "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "format": "json"}},
+		{Tool: "gh_repo_view", Args: map[string]any{"repository": "sample-repository", "branch": "sample-branch", "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "web": true, "format": "json"}},
+		{Tool: "gh_repo_clone", Args: map[string]any{"repository": "sample-repository", "directory": "sample-directory", "depth": 1, "recurse_submodules": true}},
+		{Tool: "gh_repo_fork", Args: map[string]any{"repository": "sample-repository", "clone": true, "default_branch_only": true, "fork_name": "sample-fork-name", "org": "sample-org", "remote": true, "remote_name": "sample-remote-name"}},
+		{Tool: "gh_repo_delete", Args: map[string]any{"repository": "sample-repository", "yes": true}},
+		{Tool: "gh_repo_archive", Args: map[string]any{"repository": "sample-repository", "yes": true, "repo": "sample-repo"}},
+		{Tool: "gh_repo_unarchive", Args: map[string]any{"repository": "sample-repository", "yes": true, "repo": "sample-repo"}},
+		{Tool: "gh_repo_edit", Args: map[string]any{"repository": "sample-repository", "add_topic": []any{"sample-add-topic"}, "remove_topic": []any{"sample-remove-topic"}, "allow_forking": true, "default_branch": "sample-default-branch", "delete_branch_on_merge": true, "description": "sample-description", "enable_auto_merge": true, "enable_discussions": true, "enable_issues": true, "enable_merge_commit": true, "enable_projects": true, "enable_rebase_merge": true, "enable_squash_merge": true, "enable_wiki": true, "homepage": "sample-homepage", "template": true, "visibility": "public", "repo": "sample-repo"}},
+		{Tool: "gh_repo_rename", Args: map[string]any{"new_name": "sample-new-name", "yes": true, "repo": "sample-repo"}},
+		{Tool: "gh_repo_sync", Args: map[string]any{"source": "sample-source", "branch": "sample-branch", "force": true, "repo": "sample-repo"}},
+		{Tool: "gh_repo_deploy_key_list", Args: map[string]any{"json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "repo": "sample-repo"}},
+		{Tool: "gh_repo_deploy_key_add", Args: map[string]any{"key_file": "sample-key-file", "allow_write": true, "title": "sample-title", "repo": "sample-repo"}},
+		{Tool: "gh_repo_deploy_key_delete", Args: map[string]any{"key_id": "sample-key-id", "repo": "sample-repo"}},
+		{Tool: "gh_repo_autolink_list", Args: map[string]any{"json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "web": true, "repo": "sample-repo"}},
+		{Tool: "gh_repo_autolink_create", Args: map[string]any{"key_prefix": "sample-key-prefix", "url_template": "sample-url-template", "numeric": true, "repo": "sample-repo"}},
+		{Tool: "gh_repo_autolink_view", Args: map[string]any{"id": "sample-id", "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "repo": "sample-repo"}},
+		{Tool: "gh_repo_autolink_delete", Args: map[string]any{"id": "sample-id", "yes": true, "repo": "sample-repo"}},
+		{Tool: "gh_repo_gitignore_list", Args: map[string]any{}},
+		{Tool: "gh_repo_gitignore_view", Args: map[string]any{"template": "sample-template"}},
+		{Tool: "gh_repo_license_list", Args: map[string]any{}},
+		{Tool: "gh_repo_license_view", Args: map[string]any{"license": "sample-license", "web": true}},
+	})
+}
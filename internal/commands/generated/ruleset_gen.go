@@ -2,9 +2,12 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -15,14 +18,39 @@ type RulesetListArgs struct {
 	Parents bool   `json:"parents,omitempty" jsonschema:"Whether to include rulesets configured at higher levels that also apply"`
 	Web     bool   `json:"web,omitempty" jsonschema:"Open the list of rulesets in the web browser"`
 	Repo    string `json:"repo,omitempty" jsonschema:"Select repository in OWNER/REPO format"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// rulesetListInputSpec holds the argument constraints for gh ruleset list
+var rulesetListInputSpec = toolkit.InputSpec{}
+
 // RegisterRulesetListTool registers the gh ruleset list tool
-func RegisterRulesetListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRulesetListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_ruleset_list",
 		Description: "List GitHub rulesets for a repository or organization",
+		InputSchema: toolkit.InputSchema[RulesetListArgs](rulesetListInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Rulesets",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RulesetListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh ruleset list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh ruleset list", nil, err)), nil, nil
+		}
+
 		cmd := []string{"ruleset", "list"}
 
 		if args.Limit > 0 {
@@ -45,16 +73,23 @@ func RegisterRulesetListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_ruleset_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh ruleset list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh ruleset list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -66,14 +101,39 @@ type RulesetViewArgs struct {
 	Repo    string `json:"repo,omitempty" jsonschema:"Select repository in OWNER/REPO format"`
 
 	RulesetId string `json:"ruleset_id,omitempty" jsonschema:"Ruleset ID (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// rulesetViewInputSpec holds the argument constraints for gh ruleset view
+var rulesetViewInputSpec = toolkit.InputSpec{}
+
 // RegisterRulesetViewTool registers the gh ruleset view tool
-func RegisterRulesetViewTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRulesetViewTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_ruleset_view",
 		Description: "View information about a GitHub ruleset",
+		InputSchema: toolkit.InputSchema[RulesetViewArgs](rulesetViewInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "View Ruleset",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RulesetViewArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh ruleset view", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh ruleset view", nil, err)), nil, nil
+		}
+
 		cmd := []string{"ruleset", "view"}
 
 		// Add positional argument: ruleset_id
@@ -97,16 +157,23 @@ func RegisterRulesetViewTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_ruleset_view", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh ruleset view failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh ruleset view", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -117,14 +184,39 @@ type RulesetCheckArgs struct {
 	Repo    string `json:"repo,omitempty" jsonschema:"Select repository in OWNER/REPO format"`
 
 	Branch string `json:"branch,omitempty" jsonschema:"Branch name to check (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// rulesetCheckInputSpec holds the argument constraints for gh ruleset check
+var rulesetCheckInputSpec = toolkit.InputSpec{}
+
 // RegisterRulesetCheckTool registers the gh ruleset check tool
-func RegisterRulesetCheckTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRulesetCheckTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_ruleset_check",
 		Description: "View information about GitHub rules that apply to a given branch",
+		InputSchema: toolkit.InputSchema[RulesetCheckArgs](rulesetCheckInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Check Branch Rules",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RulesetCheckArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh ruleset check", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh ruleset check", nil, err)), nil, nil
+		}
+
 		cmd := []string{"ruleset", "check"}
 
 		// Add positional argument: branch
@@ -144,15 +236,29 @@ func RegisterRulesetCheckTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_ruleset_check", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh ruleset check failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh ruleset check", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterRulesetTools registers the gh ruleset tools
+func RegisterRulesetTools(server *mcp.Server, exec executor.Runner) {
+	RegisterRulesetListTool(server, exec)
+	RegisterRulesetViewTool(server, exec)
+	RegisterRulesetCheckTool(server, exec)
+}
@@ -0,0 +1,14 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestRulesetArgv calls every gh ruleset tool with representative
+// arguments and compares the commands they run with testdata/ruleset.golden
+func TestRulesetArgv(t *testing.T) {
+	checkArgvGolden(t, "ruleset", []argvCase{
+		{Tool: "gh_ruleset_list", Args: map[string]any{"limit": 1, "org": "sample-org", "parents": true, "web": true, "repo": "sample-repo"}},
+		{Tool: "gh_ruleset_view", Args: map[string]any{"ruleset_id": "sample-ruleset-id", "org": "sample-org", "parents": true, "web": true, "repo": "sample-repo"}},
+		{Tool: "gh_ruleset_check", Args: map[string]any{"branch": "sample-branch", "default": true, "web": true, "repo": "sample-repo"}},
+	})
+}
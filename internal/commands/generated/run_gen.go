@@ -2,9 +2,12 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -18,18 +21,80 @@ type RunListArgs struct {
 	Status   string   `json:"status,omitempty" jsonschema:"Filter by status"`
 	User     string   `json:"user,omitempty" jsonschema:"Filter by user who triggered run"`
 	Workflow string   `json:"workflow,omitempty" jsonschema:"Filter by workflow"`
-	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: databaseId,displayTitle,workflowName,status,conclusion,event,headBranch,createdAt,url)"`
 	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Repo     string   `json:"repo,omitempty" jsonschema:"Select repository"`
+	Format   string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// runListInputSpec holds the argument constraints for gh run list
+var runListInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"status": {Enum: []string{"queued", "completed", "in_progress", "requested", "waiting", "pending", "action_required", "cancelled", "failure", "neutral", "skipped", "stale", "startup_failure", "success", "timed_out"}},
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
 }
 
+// runListOutputSpec describes the --json output of gh run list
+var runListOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "attempt", Type: "integer", Description: "Run attempt number"},
+		{Name: "conclusion", Type: "string", Description: "Run conclusion"},
+		{Name: "createdAt", Type: "string", Description: "When the run was created"},
+		{Name: "databaseId", Type: "integer", Description: "Run ID"},
+		{Name: "displayTitle", Type: "string", Description: "Run title"},
+		{Name: "event", Type: "string", Description: "Event that triggered the run"},
+		{Name: "headBranch", Type: "string", Description: "Branch the run ran on"},
+		{Name: "headSha", Type: "string", Description: "Commit SHA the run ran on"},
+		{Name: "name", Type: "string", Description: "Workflow name"},
+		{Name: "number", Type: "integer", Description: "Run number"},
+		{Name: "startedAt", Type: "string", Description: "When the run started"},
+		{Name: "status", Type: "string", Description: "Run status"},
+		{Name: "updatedAt", Type: "string", Description: "When the run was last updated"},
+		{Name: "url", Type: "string", Description: "Run URL"},
+		{Name: "workflowDatabaseId", Type: "integer", Description: "Workflow ID"},
+		{Name: "workflowName", Type: "string", Description: "Workflow name"},
+	},
+}
+
+// runListDefaultJSONFields are requested when no json fields are given
+var runListDefaultJSONFields = []string{"databaseId", "displayTitle", "workflowName", "status", "conclusion", "event", "headBranch", "createdAt", "url"}
+
 // RegisterRunListTool registers the gh run list tool
-func RegisterRunListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRunListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_run_list",
-		Description: "List recent workflow runs",
+		Name:         "gh_run_list",
+		Description:  "List recent workflow runs",
+		InputSchema:  toolkit.InputSchema[RunListArgs](runListInputSpec),
+		OutputSchema: toolkit.OutputSchema(runListOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Workflow Runs",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RunListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh run list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh run list", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" {
+			args.Json = runListDefaultJSONFields
+		}
+
 		cmd := []string{"run", "list"}
 
 		if args.Branch != "" {
@@ -80,16 +145,23 @@ func RegisterRunListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_run_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh run list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh run list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -108,14 +180,63 @@ type RunViewArgs struct {
 	Repo       string   `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	RunId string `json:"run_id,omitempty" jsonschema:"Run ID or number (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// runViewInputSpec holds the argument constraints for gh run view
+var runViewInputSpec = toolkit.InputSpec{}
+
+// runViewOutputSpec describes the --json output of gh run view
+var runViewOutputSpec = toolkit.OutputSpec{
+	Fields: []toolkit.OutputField{
+		{Name: "attempt", Type: "integer", Description: "Run attempt number"},
+		{Name: "conclusion", Type: "string", Description: "Run conclusion"},
+		{Name: "createdAt", Type: "string", Description: "When the run was created"},
+		{Name: "databaseId", Type: "integer", Description: "Run ID"},
+		{Name: "displayTitle", Type: "string", Description: "Run title"},
+		{Name: "event", Type: "string", Description: "Event that triggered the run"},
+		{Name: "headBranch", Type: "string", Description: "Branch the run ran on"},
+		{Name: "headSha", Type: "string", Description: "Commit SHA the run ran on"},
+		{Name: "jobs", Type: "array", Description: "Jobs in the run"},
+		{Name: "name", Type: "string", Description: "Workflow name"},
+		{Name: "number", Type: "integer", Description: "Run number"},
+		{Name: "startedAt", Type: "string", Description: "When the run started"},
+		{Name: "status", Type: "string", Description: "Run status"},
+		{Name: "updatedAt", Type: "string", Description: "When the run was last updated"},
+		{Name: "url", Type: "string", Description: "Run URL"},
+		{Name: "workflowDatabaseId", Type: "integer", Description: "Workflow ID"},
+		{Name: "workflowName", Type: "string", Description: "Workflow name"},
+	},
 }
 
 // RegisterRunViewTool registers the gh run view tool
-func RegisterRunViewTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRunViewTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_run_view",
-		Description: "View a summary of a workflow run",
+		Name:         "gh_run_view",
+		Description:  "View a summary of a workflow run",
+		InputSchema:  toolkit.InputSchema[RunViewArgs](runViewInputSpec),
+		OutputSchema: toolkit.OutputSchema(runViewOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "View Workflow Run",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RunViewArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh run view", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh run view", nil, err)), nil, nil
+		}
+
 		cmd := []string{"run", "view"}
 
 		// Add positional argument: run_id
@@ -167,34 +288,66 @@ func RegisterRunViewTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_run_view", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh run view failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh run view", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, false)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
 // RunWatchArgs defines parameters for gh run watch
 type RunWatchArgs struct {
 	ExitStatus bool   `json:"exit_status,omitempty" jsonschema:"Exit with non-zero status if run fails"`
-	Interval   int    `json:"interval,omitempty" jsonschema:"Refresh interval in seconds"`
+	Interval   *int   `json:"interval,omitempty" jsonschema:"Refresh interval in seconds"`
 	Repo       string `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	RunId string `json:"run_id,omitempty" jsonschema:"Run ID or number (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// runWatchInputSpec holds the argument constraints for gh run watch
+var runWatchInputSpec = toolkit.InputSpec{}
+
 // RegisterRunWatchTool registers the gh run watch tool
-func RegisterRunWatchTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRunWatchTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_run_watch",
 		Description: "Watch a run until it completes",
+		InputSchema: toolkit.InputSchema[RunWatchArgs](runWatchInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Watch Workflow Run",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RunWatchArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh run watch", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh run watch", nil, err)), nil, nil
+		}
+
 		cmd := []string{"run", "watch"}
 
 		// Add positional argument: run_id
@@ -206,24 +359,32 @@ func RegisterRunWatchTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--exit-status")
 		}
 
-		if args.Interval > 0 {
-			cmd = append(cmd, "--interval", fmt.Sprintf("%d", args.Interval))
+		if args.Interval != nil {
+			cmd = append(cmd, "--interval", fmt.Sprintf("%d", *args.Interval))
 		}
 
 		if args.Repo != "" {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_run_watch", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 3600),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+			OnLine:      toolkit.Progress(ctx, req),
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh run watch failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh run watch", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -235,14 +396,39 @@ type RunRerunArgs struct {
 	Repo   string `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	RunId string `json:"run_id,omitempty" jsonschema:"Run ID or number (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// runRerunInputSpec holds the argument constraints for gh run rerun
+var runRerunInputSpec = toolkit.InputSpec{}
+
 // RegisterRunRerunTool registers the gh run rerun tool
-func RegisterRunRerunTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRunRerunTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_run_rerun",
 		Description: "Rerun a run",
+		InputSchema: toolkit.InputSchema[RunRerunArgs](runRerunInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Rerun Workflow Run",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RunRerunArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh run rerun", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh run rerun", nil, err)), nil, nil
+		}
+
 		cmd := []string{"run", "rerun"}
 
 		// Add positional argument: run_id
@@ -266,16 +452,23 @@ func RegisterRunRerunTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_run_rerun", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh run rerun failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh run rerun", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -284,14 +477,39 @@ type RunCancelArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	RunId string `json:"run_id,omitempty" jsonschema:"Run ID or number (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// runCancelInputSpec holds the argument constraints for gh run cancel
+var runCancelInputSpec = toolkit.InputSpec{}
+
 // RegisterRunCancelTool registers the gh run cancel tool
-func RegisterRunCancelTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRunCancelTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_run_cancel",
 		Description: "Cancel a workflow run",
+		InputSchema: toolkit.InputSchema[RunCancelArgs](runCancelInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Cancel Workflow Run",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RunCancelArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh run cancel", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh run cancel", nil, err)), nil, nil
+		}
+
 		cmd := []string{"run", "cancel"}
 
 		// Add positional argument: run_id
@@ -303,16 +521,23 @@ func RegisterRunCancelTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_run_cancel", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh run cancel failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh run cancel", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -321,14 +546,45 @@ type RunDeleteArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	RunId string `json:"run_id,omitempty" jsonschema:"Run ID or number (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// runDeleteInputSpec holds the argument constraints for gh run delete
+var runDeleteInputSpec = toolkit.InputSpec{
+	Required: []string{"run_id"},
 }
 
 // RegisterRunDeleteTool registers the gh run delete tool
-func RegisterRunDeleteTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRunDeleteTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_run_delete",
 		Description: "Delete a workflow run",
+		InputSchema: toolkit.InputSchema[RunDeleteArgs](runDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete Workflow Run",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RunDeleteArgs) (*mcp.CallToolResult, any, error) {
+		if err := runDeleteInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh run delete", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh run delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh run delete", nil, err)), nil, nil
+		}
+
 		cmd := []string{"run", "delete"}
 
 		// Add positional argument: run_id
@@ -340,16 +596,29 @@ func RegisterRunDeleteTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh run delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_run_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh run delete failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh run delete", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -361,14 +630,39 @@ type RunDownloadArgs struct {
 	Repo    string   `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	RunId string `json:"run_id,omitempty" jsonschema:"Run ID or number (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// runDownloadInputSpec holds the argument constraints for gh run download
+var runDownloadInputSpec = toolkit.InputSpec{}
+
 // RegisterRunDownloadTool registers the gh run download tool
-func RegisterRunDownloadTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterRunDownloadTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_run_download",
 		Description: "Download artifacts from a run",
+		InputSchema: toolkit.InputSchema[RunDownloadArgs](runDownloadInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Download Run Artifacts",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args RunDownloadArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh run download", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh run download", nil, err)), nil, nil
+		}
+
 		cmd := []string{"run", "download"}
 
 		// Add positional argument: run_id
@@ -392,15 +686,34 @@ func RegisterRunDownloadTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_run_download", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 1800),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+			OnLine:      toolkit.Progress(ctx, req),
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh run download failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh run download", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterRunTools registers the gh run tools
+func RegisterRunTools(server *mcp.Server, exec executor.Runner) {
+	RegisterRunListTool(server, exec)
+	RegisterRunViewTool(server, exec)
+	RegisterRunWatchTool(server, exec)
+	RegisterRunRerunTool(server, exec)
+	RegisterRunCancelTool(server, exec)
+	RegisterRunDeleteTool(server, exec)
+	RegisterRunDownloadTool(server, exec)
+}
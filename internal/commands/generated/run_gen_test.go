@@ -0,0 +1,18 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestRunArgv calls every gh run tool with representative
+// arguments and compares the commands they run with testdata/run.golden
+func TestRunArgv(t *testing.T) {
+	checkArgvGolden(t, "run", []argvCase{
+		{Tool: "gh_run_list", Args: map[string]any{"branch": "sample-branch", "commit": "sample-commit", "created": "sample-created", "event": "sample-event", "limit": 1, "status": "queued", "user": "sample-user", "workflow": "sample-workflow", "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "repo": "sample-repo", "format": "json"}},
+		{Tool: "gh_run_view", Args: map[string]any{"run_id": "sample-run-id", "attempt": 1, "exit_status": true, "job": "sample-job", "log": true, "log_failed": true, "verbose": true, "web": true, "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "repo": "sample-repo"}},
+		{Tool: "gh_run_watch", Args: map[string]any{"run_id": "sample-run-id", "exit_status": true, "interval": 1, "repo": "sample-repo"}},
+		{Tool: "gh_run_rerun", Args: map[string]any{"run_id": "sample-run-id", "debug": true, "failed": true, "job": "sample-job", "repo": "sample-repo"}},
+		{Tool: "gh_run_cancel", Args: map[string]any{"run_id": "sample-run-id", "repo": "sample-repo"}},
+		{Tool: "gh_run_delete", Args: map[string]any{"run_id": "sample-run-id", "repo": "sample-repo"}},
+		{Tool: "gh_run_download", Args: map[string]any{"run_id": "sample-run-id", "dir": "sample-dir", "name": []any{"sample-name"}, "pattern": []any{"sample-pattern"}, "repo": "sample-repo"}},
+	})
+}
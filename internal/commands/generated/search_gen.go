@@ -2,9 +2,12 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -30,20 +33,102 @@ type SearchReposArgs struct {
 	Updated          string   `json:"updated,omitempty" jsonschema:"Filter by last update date"`
 	Visibility       []string `json:"visibility,omitempty" jsonschema:"Filter by visibility"`
 	Limit            int      `json:"limit,omitempty" jsonschema:"Maximum number of results"`
-	Json             []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json             []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: fullName,description,visibility,language,stargazersCount,updatedAt,url)"`
 	Jq               string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template         string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Web              bool     `json:"web,omitempty" jsonschema:"Open search in browser"`
+	Format           string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
 
 	Query string `json:"query,omitempty" jsonschema:"Search query (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// searchReposInputSpec holds the argument constraints for gh search repos
+var searchReposInputSpec = toolkit.InputSpec{
+	Required: []string{"query"},
+	Properties: map[string]toolkit.Property{
+		"include_forks": {Enum: []string{"true", "false", "only"}},
+		"match":         {Enum: []string{"name", "description", "readme"}},
+		"order":         {Enum: []string{"asc", "desc"}},
+		"sort":          {Enum: []string{"forks", "help-wanted-issues", "stars", "updated"}},
+		"visibility":    {Enum: []string{"public", "private", "internal"}},
+		"format":        {Enum: []string{"json", "text"}, Default: "json"},
+	},
 }
 
+// searchReposOutputSpec describes the --json output of gh search repos
+var searchReposOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "createdAt", Type: "string", Description: "When the repository was created"},
+		{Name: "defaultBranch", Type: "string", Description: "Default branch name"},
+		{Name: "description", Type: "string", Description: "Repository description"},
+		{Name: "forksCount", Type: "integer", Description: "Number of forks"},
+		{Name: "fullName", Type: "string", Description: "Repository name in OWNER/REPO format"},
+		{Name: "hasDownloads", Type: "boolean", Description: "Whether downloads are enabled"},
+		{Name: "hasIssues", Type: "boolean", Description: "Whether issues are enabled"},
+		{Name: "hasPages", Type: "boolean", Description: "Whether Pages is enabled"},
+		{Name: "hasProjects", Type: "boolean", Description: "Whether projects are enabled"},
+		{Name: "hasWiki", Type: "boolean", Description: "Whether the wiki is enabled"},
+		{Name: "homepage", Type: "string", Description: "Homepage URL"},
+		{Name: "id", Type: "string", Description: "Node ID"},
+		{Name: "isArchived", Type: "boolean", Description: "Whether the repository is archived"},
+		{Name: "isDisabled", Type: "boolean", Description: "Whether the repository is disabled"},
+		{Name: "isFork", Type: "boolean", Description: "Whether the repository is a fork"},
+		{Name: "isPrivate", Type: "boolean", Description: "Whether the repository is private"},
+		{Name: "language", Type: "string", Description: "Primary language"},
+		{Name: "license", Type: "object", Description: "License"},
+		{Name: "name", Type: "string", Description: "Repository name"},
+		{Name: "openIssuesCount", Type: "integer", Description: "Number of open issues"},
+		{Name: "owner", Type: "object", Description: "Repository owner"},
+		{Name: "pushedAt", Type: "string", Description: "When the repository was last pushed to"},
+		{Name: "size", Type: "integer", Description: "Size in kilobytes"},
+		{Name: "stargazersCount", Type: "integer", Description: "Number of stars"},
+		{Name: "updatedAt", Type: "string", Description: "When the repository was last updated"},
+		{Name: "url", Type: "string", Description: "Repository URL"},
+		{Name: "visibility", Type: "string", Description: "Repository visibility"},
+		{Name: "watchersCount", Type: "integer", Description: "Number of watchers"},
+	},
+}
+
+// searchReposDefaultJSONFields are requested when no json fields are given
+var searchReposDefaultJSONFields = []string{"fullName", "description", "visibility", "language", "stargazersCount", "updatedAt", "url"}
+
 // RegisterSearchReposTool registers the gh search repos tool
-func RegisterSearchReposTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterSearchReposTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_search_repos",
-		Description: "Search for repositories",
+		Name:         "gh_search_repos",
+		Description:  "Search for repositories",
+		InputSchema:  toolkit.InputSchema[SearchReposArgs](searchReposInputSpec),
+		OutputSchema: toolkit.OutputSchema(searchReposOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Search Repositories",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args SearchReposArgs) (*mcp.CallToolResult, any, error) {
+		if err := searchReposInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh search repos", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh search repos", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh search repos", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" && !args.Web {
+			args.Json = searchReposDefaultJSONFields
+		}
+
 		cmd := []string{"search", "repos"}
 
 		// Add positional argument: query
@@ -147,16 +232,23 @@ func RegisterSearchReposTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--web")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_search_repos", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh search repos failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh search repos", result, err)), nil, nil
+		}
+
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -184,20 +276,90 @@ type SearchIssuesArgs struct {
 	State       string   `json:"state,omitempty" jsonschema:"Filter by state"`
 	Updated     string   `json:"updated,omitempty" jsonschema:"Filter by updated date"`
 	Limit       int      `json:"limit,omitempty" jsonschema:"Maximum number of results"`
-	Json        []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json        []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: number,title,state,repository,author,labels,createdAt,url)"`
 	Jq          string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template    string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Web         bool     `json:"web,omitempty" jsonschema:"Open search in browser"`
+	Format      string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
 
 	Query string `json:"query,omitempty" jsonschema:"Search query (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// searchIssuesInputSpec holds the argument constraints for gh search issues
+var searchIssuesInputSpec = toolkit.InputSpec{
+	Required: []string{"query"},
+	Properties: map[string]toolkit.Property{
+		"match":  {Enum: []string{"title", "body", "comments"}},
+		"order":  {Enum: []string{"asc", "desc"}},
+		"sort":   {Enum: []string{"comments", "created", "interactions", "reactions", "reactions-+1", "reactions--1", "reactions-heart", "reactions-smile", "reactions-tada", "reactions-thinking_face", "updated"}},
+		"state":  {Enum: []string{"open", "closed"}},
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
 }
 
+// searchIssuesOutputSpec describes the --json output of gh search issues
+var searchIssuesOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "assignees", Type: "array", Description: "Assigned users"},
+		{Name: "author", Type: "object", Description: "Author"},
+		{Name: "authorAssociation", Type: "string", Description: "Author's association with the repository"},
+		{Name: "body", Type: "string", Description: "Body text"},
+		{Name: "closedAt", Type: "string", Description: "When it was closed"},
+		{Name: "commentsCount", Type: "integer", Description: "Number of comments"},
+		{Name: "createdAt", Type: "string", Description: "When it was created"},
+		{Name: "id", Type: "string", Description: "Node ID"},
+		{Name: "isLocked", Type: "boolean", Description: "Whether the conversation is locked"},
+		{Name: "isPullRequest", Type: "boolean", Description: "Whether the result is a pull request"},
+		{Name: "labels", Type: "array", Description: "Applied labels"},
+		{Name: "number", Type: "integer", Description: "Issue or pull request number"},
+		{Name: "repository", Type: "object", Description: "Repository"},
+		{Name: "state", Type: "string", Description: "State"},
+		{Name: "title", Type: "string", Description: "Title"},
+		{Name: "updatedAt", Type: "string", Description: "When it was last updated"},
+		{Name: "url", Type: "string", Description: "URL"},
+	},
+}
+
+// searchIssuesDefaultJSONFields are requested when no json fields are given
+var searchIssuesDefaultJSONFields = []string{"number", "title", "state", "repository", "author", "labels", "createdAt", "url"}
+
 // RegisterSearchIssuesTool registers the gh search issues tool
-func RegisterSearchIssuesTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterSearchIssuesTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_search_issues",
-		Description: "Search for issues",
+		Name:         "gh_search_issues",
+		Description:  "Search for issues",
+		InputSchema:  toolkit.InputSchema[SearchIssuesArgs](searchIssuesInputSpec),
+		OutputSchema: toolkit.OutputSchema(searchIssuesOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Search Issues",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args SearchIssuesArgs) (*mcp.CallToolResult, any, error) {
+		if err := searchIssuesInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh search issues", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh search issues", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh search issues", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" && !args.Web {
+			args.Json = searchIssuesDefaultJSONFields
+		}
+
 		cmd := []string{"search", "issues"}
 
 		// Add positional argument: query
@@ -309,16 +471,23 @@ func RegisterSearchIssuesTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--web")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_search_issues", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh search issues failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh search issues", result, err)), nil, nil
+		}
+
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -349,20 +518,92 @@ type SearchPrsArgs struct {
 	TeamReview string   `json:"team_review,omitempty" jsonschema:"Filter by team requested to review"`
 	Updated    string   `json:"updated,omitempty" jsonschema:"Filter by updated date"`
 	Limit      int      `json:"limit,omitempty" jsonschema:"Maximum number of results"`
-	Json       []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json       []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: number,title,state,repository,author,isDraft,createdAt,url)"`
 	Jq         string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template   string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Web        bool     `json:"web,omitempty" jsonschema:"Open search in browser"`
+	Format     string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
 
 	Query string `json:"query,omitempty" jsonschema:"Search query (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// searchPrsInputSpec holds the argument constraints for gh search prs
+var searchPrsInputSpec = toolkit.InputSpec{
+	Required: []string{"query"},
+	Properties: map[string]toolkit.Property{
+		"match":  {Enum: []string{"title", "body", "comments"}},
+		"order":  {Enum: []string{"asc", "desc"}},
+		"review": {Enum: []string{"none", "required", "approved", "changes_requested"}},
+		"sort":   {Enum: []string{"comments", "created", "interactions", "reactions", "reactions-+1", "reactions--1", "reactions-heart", "reactions-smile", "reactions-tada", "reactions-thinking_face", "updated"}},
+		"state":  {Enum: []string{"open", "closed"}},
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
 }
 
+// searchPrsOutputSpec describes the --json output of gh search prs
+var searchPrsOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "assignees", Type: "array", Description: "Assigned users"},
+		{Name: "author", Type: "object", Description: "Author"},
+		{Name: "authorAssociation", Type: "string", Description: "Author's association with the repository"},
+		{Name: "body", Type: "string", Description: "Body text"},
+		{Name: "closedAt", Type: "string", Description: "When it was closed"},
+		{Name: "commentsCount", Type: "integer", Description: "Number of comments"},
+		{Name: "createdAt", Type: "string", Description: "When it was created"},
+		{Name: "id", Type: "string", Description: "Node ID"},
+		{Name: "isDraft", Type: "boolean", Description: "Whether the pull request is a draft"},
+		{Name: "isLocked", Type: "boolean", Description: "Whether the conversation is locked"},
+		{Name: "isPullRequest", Type: "boolean", Description: "Whether the result is a pull request"},
+		{Name: "labels", Type: "array", Description: "Applied labels"},
+		{Name: "number", Type: "integer", Description: "Issue or pull request number"},
+		{Name: "repository", Type: "object", Description: "Repository"},
+		{Name: "state", Type: "string", Description: "State"},
+		{Name: "title", Type: "string", Description: "Title"},
+		{Name: "updatedAt", Type: "string", Description: "When it was last updated"},
+		{Name: "url", Type: "string", Description: "URL"},
+	},
+}
+
+// searchPrsDefaultJSONFields are requested when no json fields are given
+var searchPrsDefaultJSONFields = []string{"number", "title", "state", "repository", "author", "isDraft", "createdAt", "url"}
+
 // RegisterSearchPrsTool registers the gh search prs tool
-func RegisterSearchPrsTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterSearchPrsTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_search_prs",
-		Description: "Search for pull requests",
+		Name:         "gh_search_prs",
+		Description:  "Search for pull requests",
+		InputSchema:  toolkit.InputSchema[SearchPrsArgs](searchPrsInputSpec),
+		OutputSchema: toolkit.OutputSchema(searchPrsOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Search Pull Requests",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args SearchPrsArgs) (*mcp.CallToolResult, any, error) {
+		if err := searchPrsInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh search prs", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh search prs", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh search prs", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" && !args.Web {
+			args.Json = searchPrsDefaultJSONFields
+		}
+
 		cmd := []string{"search", "prs"}
 
 		// Add positional argument: query
@@ -486,15 +727,29 @@ func RegisterSearchPrsTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--web")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_search_prs", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh search prs failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh search prs", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
+
+// RegisterSearchTools registers the gh search tools
+func RegisterSearchTools(server *mcp.Server, exec executor.Runner) {
+	RegisterSearchReposTool(server, exec)
+	RegisterSearchIssuesTool(server, exec)
+	RegisterSearchPrsTool(server, exec)
+}
@@ -0,0 +1,14 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestSearchArgv calls every gh search tool with representative
+// arguments and compares the commands they run with testdata/search.golden
+func TestSearchArgv(t *testing.T) {
+	checkArgvGolden(t, "search", []argvCase{
+		{Tool: "gh_search_repos", Args: map[string]any{"query": "sample-query", "archived": true, "created": "sample-created", "followers": "sample-followers", "forks": "sample-forks", "good_first_issue": "sample-good-first-issue", "help_wanted_issues": "sample-help-wanted-issues", "include_forks": "true", "language": "sample-language", "license": []any{"sample-license"}, "match": "name", "number_topics": "sample-number-topics", "order": "asc", "owner": []any{"sample-owner"}, "size": "sample-size", "sort": "forks", "stars": "sample-stars", "topic": []any{"sample-topic"}, "updated": "sample-updated", "visibility": []any{"public"}, "limit": 1, "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "web": true, "format": "json"}},
+		{Tool: "gh_search_issues", Args: map[string]any{"query": "sample-query", "assignee": "sample-assignee", "author": "sample-author", "closed": "sample-closed", "comments": "sample-comments", "created": "sample-created", "include_prs": true, "label": []any{"sample-label"}, "locked": true, "match": "title", "mentions": "sample-mentions", "milestone": "sample-milestone", "no_assignee": true, "no_label": true, "no_milestone": true, "no_project": true, "order": "asc", "owner": []any{"sample-owner"}, "repo": []any{"sample-repo"}, "sort": "comments", "state": "open", "updated": "sample-updated", "limit": 1, "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "web": true, "format": "json"}},
+		{Tool: "gh_search_prs", Args: map[string]any{"query": "sample-query", "archived": true, "assignee": "sample-assignee", "author": "sample-author", "base": "sample-base", "closed": "sample-closed", "comments": "sample-comments", "created": "sample-created", "draft": true, "head": "sample-head", "label": []any{"sample-label"}, "locked": true, "match": "title", "merged": true, "merged_at": "sample-merged-at", "milestone": "sample-milestone", "order": "asc", "owner": []any{"sample-owner"}, "repo": []any{"sample-repo"}, "review": "none", "reviewed_by": "sample-reviewed-by", "sort": "comments", "state": "open", "team_review": "sample-team-review", "updated": "sample-updated", "limit": 1, "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "web": true, "format": "json"}},
+	})
+}
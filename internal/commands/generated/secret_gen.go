@@ -2,9 +2,11 @@
 package generated
 
 import (
+	"cmp"
 	"context"
-	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -14,18 +16,69 @@ type SecretListArgs struct {
 	Env      string   `json:"env,omitempty" jsonschema:"List secrets for an environment"`
 	Org      string   `json:"org,omitempty" jsonschema:"List secrets for an organization"`
 	User     bool     `json:"user,omitempty" jsonschema:"List user secrets"`
-	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: name,updatedAt)"`
 	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Repo     string   `json:"repo,omitempty" jsonschema:"Select repository"`
+	Format   string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// secretListInputSpec holds the argument constraints for gh secret list
+var secretListInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"app":    {Enum: []string{"actions", "codespaces", "dependabot"}},
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
 }
 
+// secretListOutputSpec describes the --json output of gh secret list
+var secretListOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "name", Type: "string", Description: "Secret name"},
+		{Name: "numSelectedRepos", Type: "integer", Description: "Number of repositories with access"},
+		{Name: "selectedReposURL", Type: "string", Description: "API URL of the selected repositories"},
+		{Name: "updatedAt", Type: "string", Description: "When the secret was last updated"},
+		{Name: "visibility", Type: "string", Description: "Organization secret visibility"},
+	},
+}
+
+// secretListDefaultJSONFields are requested when no json fields are given
+var secretListDefaultJSONFields = []string{"name", "updatedAt"}
+
 // RegisterSecretListTool registers the gh secret list tool
-func RegisterSecretListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterSecretListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_secret_list",
-		Description: "List secrets",
+		Name:         "gh_secret_list",
+		Description:  "List secrets",
+		InputSchema:  toolkit.InputSchema[SecretListArgs](secretListInputSpec),
+		OutputSchema: toolkit.OutputSchema(secretListOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Secrets",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args SecretListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh secret list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh secret list", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" {
+			args.Json = secretListDefaultJSONFields
+		}
+
 		cmd := []string{"secret", "list"}
 
 		if args.App != "" {
@@ -60,23 +113,30 @@ func RegisterSecretListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_secret_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh secret list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh secret list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
 // SecretSetArgs defines parameters for gh secret set
 type SecretSetArgs struct {
 	App        string   `json:"app,omitempty" jsonschema:"Set secret for Actions or Dependabot"`
-	Body       string   `json:"body,omitempty" jsonschema:"Secret value (reads from STDIN if not specified)"`
+	Body       string   `json:"body,omitempty" jsonschema:"Secret value, sent to gh on standard input"`
 	BodyFile   string   `json:"body_file,omitempty" jsonschema:"Read secret value from file"`
 	Env        string   `json:"env,omitempty" jsonschema:"Set secret for an environment"`
 	NoStore    bool     `json:"no_store,omitempty" jsonschema:"Do not store secret in org/repo secret manager"`
@@ -87,14 +147,48 @@ type SecretSetArgs struct {
 	Repo       string   `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	SecretName string `json:"secret_name,omitempty" jsonschema:"Name of the secret (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// secretSetInputSpec holds the argument constraints for gh secret set
+var secretSetInputSpec = toolkit.InputSpec{
+	Required: []string{"secret_name"},
+	Properties: map[string]toolkit.Property{
+		"app":        {Enum: []string{"actions", "dependabot", "codespaces"}},
+		"visibility": {Enum: []string{"all", "private", "selected"}},
+	},
 }
 
 // RegisterSecretSetTool registers the gh secret set tool
-func RegisterSecretSetTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterSecretSetTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_secret_set",
 		Description: "Create or update secrets",
+		InputSchema: toolkit.InputSchema[SecretSetArgs](secretSetInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Set Secret",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args SecretSetArgs) (*mcp.CallToolResult, any, error) {
+		if err := secretSetInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh secret set", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh secret set", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh secret set", nil, err)), nil, nil
+		}
+
 		cmd := []string{"secret", "set"}
 
 		// Add positional argument: secret_name
@@ -106,10 +200,6 @@ func RegisterSecretSetTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--app", args.App)
 		}
 
-		if args.Body != "" {
-			cmd = append(cmd, "--body", args.Body)
-		}
-
 		if args.BodyFile != "" {
 			cmd = append(cmd, "--body-file", args.BodyFile)
 		}
@@ -142,16 +232,24 @@ func RegisterSecretSetTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_secret_set", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+			Stdin:       args.Body,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh secret set failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh secret set", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -164,14 +262,48 @@ type SecretRemoveArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	SecretName string `json:"secret_name,omitempty" jsonschema:"Name of the secret (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// secretRemoveInputSpec holds the argument constraints for gh secret remove
+var secretRemoveInputSpec = toolkit.InputSpec{
+	Required: []string{"secret_name"},
+	Properties: map[string]toolkit.Property{
+		"app": {Enum: []string{"actions", "dependabot", "codespaces"}},
+	},
 }
 
 // RegisterSecretRemoveTool registers the gh secret remove tool
-func RegisterSecretRemoveTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterSecretRemoveTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_secret_remove",
 		Description: "Remove secrets",
+		InputSchema: toolkit.InputSchema[SecretRemoveArgs](secretRemoveInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Remove Secret",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args SecretRemoveArgs) (*mcp.CallToolResult, any, error) {
+		if err := secretRemoveInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh secret remove", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh secret remove", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh secret remove", nil, err)), nil, nil
+		}
+
 		cmd := []string{"secret", "remove"}
 
 		// Add positional argument: secret_name
@@ -199,15 +331,35 @@ func RegisterSecretRemoveTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh secret remove", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_secret_remove", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh secret remove failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh secret remove", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterSecretTools registers the gh secret tools
+func RegisterSecretTools(server *mcp.Server, exec executor.Runner) {
+	RegisterSecretListTool(server, exec)
+	RegisterSecretSetTool(server, exec)
+	RegisterSecretRemoveTool(server, exec)
+}
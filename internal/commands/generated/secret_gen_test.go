@@ -0,0 +1,14 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestSecretArgv calls every gh secret tool with representative
+// arguments and compares the commands they run with testdata/secret.golden
+func TestSecretArgv(t *testing.T) {
+	checkArgvGolden(t, "secret", []argvCase{
+		{Tool: "gh_secret_list", Args: map[string]any{"app": "actions", "env": "sample-env", "org": "sample-org", "user": true, "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "repo": "sample-repo", "format": "json"}},
+		{Tool: "gh_secret_set", Args: map[string]any{"secret_name": "sample-secret-name", "app": "actions", "body": "sample-body", "body_file": "sample-body-file", "env": "sample-env", "no_store": true, "org": "sample-org", "repos": []any{"sample-repos"}, "user": true, "visibility": "all", "repo": "sample-repo"}},
+		{Tool: "gh_secret_remove", Args: map[string]any{"secret_name": "sample-secret-name", "app": "actions", "env": "sample-env", "org": "sample-org", "user": true, "repo": "sample-repo"}},
+	})
+}
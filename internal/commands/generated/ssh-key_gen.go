@@ -2,34 +2,67 @@
 package generated
 
 import (
+	"cmp"
 	"context"
-	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // SshKeyListArgs defines parameters for gh ssh-key list
 type SshKeyListArgs struct {
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
+// sshKeyListInputSpec holds the argument constraints for gh ssh-key list
+var sshKeyListInputSpec = toolkit.InputSpec{}
+
 // RegisterSshKeyListTool registers the gh ssh-key list tool
-func RegisterSshKeyListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterSshKeyListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_ssh-key_list",
 		Description: "Lists SSH keys in your GitHub account",
+		InputSchema: toolkit.InputSchema[SshKeyListArgs](sshKeyListInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List SSH Keys",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args SshKeyListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh ssh-key list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh ssh-key list", nil, err)), nil, nil
+		}
+
 		cmd := []string{"ssh-key", "list"}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_ssh-key_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh ssh-key list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh ssh-key list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -39,14 +72,43 @@ type SshKeyAddArgs struct {
 	Type  string `json:"type,omitempty" jsonschema:"Type of the SSH key"`
 
 	KeyFile string `json:"key_file,omitempty" jsonschema:"Path to SSH key file (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// sshKeyAddInputSpec holds the argument constraints for gh ssh-key add
+var sshKeyAddInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"type": {Enum: []string{"authentication", "signing"}},
+	},
 }
 
 // RegisterSshKeyAddTool registers the gh ssh-key add tool
-func RegisterSshKeyAddTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterSshKeyAddTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_ssh-key_add",
 		Description: "Add an SSH key to your GitHub account",
+		InputSchema: toolkit.InputSchema[SshKeyAddArgs](sshKeyAddInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Add SSH Key",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args SshKeyAddArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh ssh-key add", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh ssh-key add", nil, err)), nil, nil
+		}
+
 		cmd := []string{"ssh-key", "add"}
 
 		// Add positional argument: key_file
@@ -62,16 +124,23 @@ func RegisterSshKeyAddTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--type", args.Type)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_ssh-key_add", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh ssh-key add failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh ssh-key add", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -80,14 +149,45 @@ type SshKeyDeleteArgs struct {
 	Yes bool `json:"yes,omitempty" jsonschema:"Skip the confirmation prompt"`
 
 	Id string `json:"id,omitempty" jsonschema:"SSH key ID (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// sshKeyDeleteInputSpec holds the argument constraints for gh ssh-key delete
+var sshKeyDeleteInputSpec = toolkit.InputSpec{
+	Required: []string{"id"},
 }
 
 // RegisterSshKeyDeleteTool registers the gh ssh-key delete tool
-func RegisterSshKeyDeleteTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterSshKeyDeleteTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_ssh-key_delete",
 		Description: "Delete an SSH key from your GitHub account",
+		InputSchema: toolkit.InputSchema[SshKeyDeleteArgs](sshKeyDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete SSH Key",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args SshKeyDeleteArgs) (*mcp.CallToolResult, any, error) {
+		if err := sshKeyDeleteInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh ssh-key delete", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh ssh-key delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh ssh-key delete", nil, err)), nil, nil
+		}
+
 		cmd := []string{"ssh-key", "delete"}
 
 		// Add positional argument: id
@@ -99,15 +199,35 @@ func RegisterSshKeyDeleteTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--yes")
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh ssh-key delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_ssh-key_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh ssh-key delete failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh ssh-key delete", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterSshKeyTools registers the gh ssh-key tools
+func RegisterSshKeyTools(server *mcp.Server, exec executor.Runner) {
+	RegisterSshKeyListTool(server, exec)
+	RegisterSshKeyAddTool(server, exec)
+	RegisterSshKeyDeleteTool(server, exec)
+}
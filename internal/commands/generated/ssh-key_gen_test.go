@@ -0,0 +1,14 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestSshKeyArgv calls every gh ssh-key tool with representative
+// arguments and compares the commands they run with testdata/ssh-key.golden
+func TestSshKeyArgv(t *testing.T) {
+	checkArgvGolden(t, "ssh-key", []argvCase{
+		{Tool: "gh_ssh-key_list", Args: map[string]any{}},
+		{Tool: "gh_ssh-key_add", Args: map[string]any{"key_file": "sample-key-file", "title": "sample-title", "type": "authentication"}},
+		{Tool: "gh_ssh-key_delete", Args: map[string]any{"id": "sample-id", "yes": true}},
+	})
+}
@@ -2,25 +2,52 @@
 package generated
 
 import (
+	"cmp"
 	"context"
-	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
-// StatusStatusArgs defines parameters for gh status status
+// StatusStatusArgs defines parameters for gh status
 type StatusStatusArgs struct {
 	Exclude []string `json:"exclude,omitempty" jsonschema:"Comma separated list of repos to exclude in owner/name format"`
 	Org     string   `json:"org,omitempty" jsonschema:"Report status within an organization"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
 }
 
-// RegisterStatusStatusTool registers the gh status status tool
-func RegisterStatusStatusTool(server *mcp.Server, exec *executor.Executor) {
+// statusStatusInputSpec holds the argument constraints for gh status
+var statusStatusInputSpec = toolkit.InputSpec{}
+
+// RegisterStatusStatusTool registers the gh status tool
+func RegisterStatusStatusTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_status_status",
 		Description: "Show status of relevant issues, pull requests, and notifications",
+		InputSchema: toolkit.InputSchema[StatusStatusArgs](statusStatusInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "GitHub Status Overview",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args StatusStatusArgs) (*mcp.CallToolResult, any, error) {
-		cmd := []string{"status", "status"}
+
+		defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh status", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh status", nil, err)), nil, nil
+		}
+
+		cmd := []string{"status"}
 
 		for _, v := range args.Exclude {
 			cmd = append(cmd, "--exclude", v)
@@ -30,15 +57,27 @@ func RegisterStatusStatusTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--org", args.Org)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_status_status", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh status status failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh status", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterStatusTools registers the gh status tools
+func RegisterStatusTools(server *mcp.Server, exec executor.Runner) {
+	RegisterStatusStatusTool(server, exec)
+}
@@ -0,0 +1,12 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestStatusArgv calls every gh status tool with representative
+// arguments and compares the commands they run with testdata/status.golden
+func TestStatusArgv(t *testing.T) {
+	checkArgvGolden(t, "status", []argvCase{
+		{Tool: "gh_status_status", Args: map[string]any{"exclude": []any{"sample-exclude"}, "org": "sample-org"}},
+	})
+}
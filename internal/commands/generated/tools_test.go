@@ -0,0 +1,432 @@
+package generated
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/executor/executortest"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+)
+
+// TestAllTools calls every tool with its required arguments and checks that
+// it runs exactly one command of its gh command group.
+func TestAllTools(t *testing.T) {
+	exec := executortest.NewRunner()
+	server := mcp.NewServer(&mcp.Implementation{Name: "mcp-go-gh-test", Version: "test"}, nil)
+	RegisterAllTools(server, exec)
+	cs := connect(t, server)
+	ctx := context.Background()
+
+	tools, err := cs.ListTools(ctx, nil)
+	require.NoError(t, err)
+	schemas := make(map[string]any)
+	for _, tool := range tools.Tools {
+		schemas[tool.Name] = tool.InputSchema
+	}
+
+	for _, ts := range Toolsets {
+		for _, tool := range ts.Tools {
+			t.Run(tool.Name, func(t *testing.T) {
+				exec.Reset()
+				result, err := cs.CallTool(ctx, &mcp.CallToolParams{Name: tool.Name, Arguments: requiredArgs(schemas[tool.Name])})
+				require.NoError(t, err)
+				assert.False(t, result.IsError, "%+v", result.Content)
+
+				calls := exec.Calls()
+				require.Len(t, calls, 1)
+				assert.Equal(t, tool.Name, calls[0].Tool)
+				require.NotEmpty(t, calls[0].Argv)
+				assert.Equal(t, ts.Name, calls[0].Argv[0], "argv %q", calls[0].Argv)
+			})
+		}
+	}
+}
+
+// TestToolCalls checks the exact argv and result of representative tools.
+func TestToolCalls(t *testing.T) {
+	tests := []struct {
+		name     string
+		tool     string
+		args     map[string]any
+		response executortest.Response
+		wantArgv []string
+		wantText string
+		wantErr  executor.ErrorKind
+	}{
+		{
+			name:     "positional arguments precede flags",
+			tool:     "gh_issue_close",
+			args:     map[string]any{"number": "42", "comment": "done", "repo": "octo/hello"},
+			wantArgv: []string{"issue", "close", "42", "--comment", "done", "--repo", "octo/hello"},
+		},
+		{
+			name:     "text output is returned as is",
+			tool:     "gh_pr_diff",
+			args:     map[string]any{"number": "7"},
+			response: executortest.Response{Result: &executor.Result{Stdout: "diff --git a/x b/x\n"}},
+			wantArgv: []string{"pr", "diff", "7"},
+			wantText: "diff --git a/x b/x\n",
+		},
+		{
+			name:     "json output requests the default fields",
+			tool:     "gh_pr_list",
+			args:     map[string]any{"limit": 5, "state": "open"},
+			response: executortest.Response{Result: &executor.Result{Stdout: `[{"number":1}]`}},
+			wantArgv: append([]string{"pr", "list", "--state", "open", "--limit", "5"}, repeatFlag("--json", prListDefaultJSONFields)...),
+			wantText: `[{"number":1}]`,
+		},
+		{
+			name:     "comments skip the default fields, which gh would print instead",
+			tool:     "gh_pr_view",
+			args:     map[string]any{"number": "7", "comments": true},
+			response: executortest.Response{Result: &executor.Result{Stdout: "title:\tFix\n--\nocto: LGTM\n"}},
+			wantArgv: []string{"pr", "view", "7", "--comments"},
+			wantText: "title:\tFix\n--\nocto: LGTM\n",
+		},
+		{
+			name: "failures are reported as error results",
+			tool: "gh_repo_view",
+			args: map[string]any{"repository": "octo/missing", "format": "text"},
+			response: executortest.Response{
+				Result: &executor.Result{Stderr: "GraphQL: Could not resolve to a Repository", ExitCode: 1},
+				Err:    errors.New("gh command failed (exit 1)"),
+			},
+			wantArgv: []string{"repo", "view", "octo/missing"},
+			wantErr:  executor.KindNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := executortest.NewRunner()
+			if tt.response.Result != nil {
+				exec.Respond(tt.tool, tt.response)
+			}
+			server := mcp.NewServer(&mcp.Implementation{Name: "mcp-go-gh-test", Version: "test"}, nil)
+			RegisterAllTools(server, exec)
+
+			result, err := connect(t, server).CallTool(context.Background(), &mcp.CallToolParams{Name: tt.tool, Arguments: tt.args})
+			require.NoError(t, err)
+
+			call, ok := exec.LastCall()
+			require.True(t, ok)
+			assert.Equal(t, tt.tool, call.Tool)
+			assert.Equal(t, tt.wantArgv, call.Argv)
+
+			if tt.wantErr != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, string(tt.wantErr), result.StructuredContent.(map[string]any)["kind"])
+				return
+			}
+			require.False(t, result.IsError, "%+v", result.Content)
+			if tt.wantText != "" {
+				assert.Equal(t, tt.wantText, result.Content[0].(*mcp.TextContent).Text)
+			}
+		})
+	}
+}
+
+// TestInputValidation checks how invalid arguments are rejected before any
+// command runs: schema violations by the SDK, as a protocol error, and empty
+// required arguments by the tool, as a validation error result.
+func TestInputValidation(t *testing.T) {
+	exec := executortest.NewRunner()
+	server := mcp.NewServer(&mcp.Implementation{Name: "mcp-go-gh-test", Version: "test"}, nil)
+	RegisterAllTools(server, exec)
+	cs := connect(t, server)
+	ctx := context.Background()
+
+	_, err := cs.CallTool(ctx, &mcp.CallToolParams{Name: "gh_run_list", Arguments: map[string]any{"status": "bogus"}})
+	require.Error(t, err, "values outside an enum")
+	assert.Contains(t, err.Error(), "status")
+
+	_, err = cs.CallTool(ctx, &mcp.CallToolParams{Name: "gh_label_delete", Arguments: map[string]any{"yes": true}})
+	require.Error(t, err, "missing required arguments")
+	assert.Contains(t, err.Error(), "name")
+
+	result, err := cs.CallTool(ctx, &mcp.CallToolParams{Name: "gh_label_delete", Arguments: map[string]any{"name": ""}})
+	require.NoError(t, err)
+	require.True(t, result.IsError, "empty required arguments")
+	assert.Equal(t, string(executor.KindValidation), result.StructuredContent.(map[string]any)["kind"])
+	assert.Contains(t, result.StructuredContent.(map[string]any)["message"], `required argument "name" is empty`)
+
+	assert.Empty(t, exec.Calls())
+}
+
+// TestOutputSchemas calls every tool with an output schema in each of its
+// output modes, and checks that it always returns structured content that
+// conforms to the schema.
+func TestOutputSchemas(t *testing.T) {
+	server := mcp.NewServer(&mcp.Implementation{Name: "mcp-go-gh-test", Version: "test"}, nil)
+	RegisterAllTools(server, executortest.NewRunner())
+	ctx := context.Background()
+
+	tools, err := connect(t, server).ListTools(ctx, nil)
+	require.NoError(t, err)
+	registers := make(map[string]func(*mcp.Server, executor.Runner))
+	for _, ts := range Toolsets {
+		for _, tool := range ts.Tools {
+			registers[tool.Name] = tool.Register
+		}
+	}
+
+	for _, tool := range tools.Tools {
+		if tool.OutputSchema == nil {
+			continue
+		}
+		t.Run(tool.Name, func(t *testing.T) {
+			schema := resolveSchema(t, tool.OutputSchema)
+			properties := tool.InputSchema.(map[string]any)["properties"].(map[string]any)
+			jsonStdout := "{}"
+			if _, ok := schema.Schema().Properties["items"]; ok {
+				jsonStdout = "[]"
+			}
+
+			modes := map[string]struct {
+				args   map[string]any
+				stdout string
+			}{
+				"default":         {stdout: jsonStdout},
+				"json":            {args: map[string]any{"json": []any{"id"}}, stdout: jsonStdout},
+				"non-JSON stdout": {args: map[string]any{"json": []any{"id"}}, stdout: "no results\n"},
+			}
+			if _, ok := properties["format"]; ok {
+				modes["format text"] = struct {
+					args   map[string]any
+					stdout string
+				}{args: map[string]any{"format": "text"}, stdout: "#1  Bug\n"}
+			}
+			for _, name := range []string{"jq", "template"} {
+				if _, ok := properties[name]; ok {
+					modes[name] = struct {
+						args   map[string]any
+						stdout string
+					}{args: map[string]any{"json": []any{"id"}, name: "x"}, stdout: "1\n"}
+				}
+			}
+
+			for mode, m := range modes {
+				args := requiredArgs(tool.InputSchema)
+				for k, v := range m.args {
+					args[k] = v
+				}
+				exec := executortest.NewRunner()
+				exec.RespondStdout(tool.Name, m.stdout)
+				server := mcp.NewServer(&mcp.Implementation{Name: "mcp-go-gh-test", Version: "test"}, nil)
+				registers[tool.Name](server, exec)
+
+				result, err := connect(t, server).CallTool(ctx, &mcp.CallToolParams{Name: tool.Name, Arguments: args})
+				require.NoError(t, err, mode)
+				require.False(t, result.IsError, "%s: %+v", mode, result.Content)
+				require.NotNil(t, result.StructuredContent, mode)
+				assert.NoError(t, schema.Validate(result.StructuredContent), mode)
+			}
+		})
+	}
+}
+
+// resolveSchema decodes and resolves a JSON schema listed by a client.
+func resolveSchema(t *testing.T, schema any) *jsonschema.Resolved {
+	t.Helper()
+	data, err := json.Marshal(schema)
+	require.NoError(t, err)
+	var s jsonschema.Schema
+	require.NoError(t, json.Unmarshal(data, &s))
+	resolved, err := s.Resolve(nil)
+	require.NoError(t, err)
+	return resolved
+}
+
+// TestRedactGeneratedArgs checks that secrets in the command lines tools
+// actually build, not just hand-written ones, are redacted.
+func TestRedactGeneratedArgs(t *testing.T) {
+	exec := executortest.NewRunner()
+	server := mcp.NewServer(&mcp.Implementation{Name: "mcp-go-gh-test", Version: "test"}, nil)
+	RegisterAllTools(server, exec)
+
+	result, err := connect(t, server).CallTool(context.Background(), &mcp.CallToolParams{Name: "gh_api_request", Arguments: map[string]any{
+		"endpoint": "user",
+		"header":   map[string]any{"Authorization": "Bearer s3cr3t", "Accept": "application/json"},
+	}})
+	require.NoError(t, err)
+	require.False(t, result.IsError, "%+v", result.Content)
+
+	call, ok := exec.LastCall()
+	require.True(t, ok)
+	assert.Equal(t, []string{"api", "user", "-H", "Accept:application/json", "-H", "Authorization:Bearer s3cr3t"}, call.Argv, "gh takes headers as name:value")
+	redactor := executor.NewRedactor(executor.DefaultRedactionRules())
+	assert.Equal(t, "api user -H Accept:application/json -H Authorization: [REDACTED]", redactor.Args(call.Argv))
+}
+
+// TestConfirmDestructive checks which tools ask for confirmation with
+// --confirm-destructive, for a client that cannot show a prompt.
+func TestConfirmDestructive(t *testing.T) {
+	tests := []struct {
+		name        string
+		tool        string
+		args        map[string]any
+		wantConfirm bool
+	}{
+		{name: "destructive tool", tool: "gh_repo_delete", args: map[string]any{"repository": "octo/hello"}, wantConfirm: true},
+		{name: "write tool", tool: "gh_issue_edit", args: map[string]any{"number": "1", "title": "t"}},
+		{name: "api GET", tool: "gh_api_request", args: map[string]any{"endpoint": "repos/octo/hello", "method": "GET"}},
+		{name: "api HEAD", tool: "gh_api_request", args: map[string]any{"endpoint": "repos/octo/hello", "method": "HEAD"}},
+		{name: "api POST", tool: "gh_api_request", args: map[string]any{"endpoint": "repos/octo/hello/issues", "method": "POST"}, wantConfirm: true},
+		{name: "api without method", tool: "gh_api_request", args: map[string]any{"endpoint": "repos/octo/hello/issues", "raw_field": map[string]any{"title": "t"}}, wantConfirm: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := executortest.NewRunner()
+			exec.SetConfirmDestructive(true)
+			server := mcp.NewServer(&mcp.Implementation{Name: "mcp-go-gh-test", Version: "test"}, nil)
+			RegisterAllTools(server, exec)
+
+			result, err := connect(t, server).CallTool(context.Background(), &mcp.CallToolParams{Name: tt.tool, Arguments: tt.args})
+			require.NoError(t, err)
+
+			if tt.wantConfirm {
+				require.True(t, result.IsError)
+				assert.Equal(t, string(executor.KindConfirmationRequired), result.StructuredContent.(map[string]any)["kind"])
+				assert.Empty(t, exec.Calls(), "no command runs before confirmation")
+				return
+			}
+			assert.False(t, result.IsError, "%+v", result.Content)
+			assert.Len(t, exec.Calls(), 1)
+		})
+	}
+}
+
+// TestConfirmDestructive_ShowsSessionDefaults checks that the command shown
+// for confirmation, and its token, include the session's repository, host
+// and directory.
+func TestConfirmDestructive_ShowsSessionDefaults(t *testing.T) {
+	exec := executortest.NewRunner()
+	exec.SetConfirmDestructive(true)
+	exec.SetWorkdir("/srv/work")
+	server := mcp.NewServer(&mcp.Implementation{Name: "mcp-go-gh-test", Version: "test"}, nil)
+	RegisterAllTools(server, exec)
+	session.RegisterTools(server)
+	cs := connect(t, server)
+	ctx := context.Background()
+
+	deleteLabel := func(token string) *mcp.CallToolResult {
+		args := map[string]any{"name": "bug"}
+		if token != "" {
+			args["confirm_token"] = token
+		}
+		result, err := cs.CallTool(ctx, &mcp.CallToolParams{Name: "gh_label_delete", Arguments: args})
+		require.NoError(t, err)
+		return result
+	}
+	setDefaults := func(args map[string]any) {
+		result, err := cs.CallTool(ctx, &mcp.CallToolParams{Name: "gh_context_set", Arguments: args})
+		require.NoError(t, err)
+		require.False(t, result.IsError, "%+v", result.Content)
+	}
+
+	setDefaults(map[string]any{"repo": "octo/hello", "hostname": "github.example.com"})
+	message := deleteLabel("").StructuredContent.(map[string]any)["message"].(string)
+	assert.Contains(t, message, "cd /srv/work && GH_REPO=octo/hello GH_HOST=github.example.com gh label delete bug")
+	token := regexp.MustCompile(`confirm_token "([^"]+)"`).FindStringSubmatch(message)
+	require.Len(t, token, 2)
+
+	setDefaults(map[string]any{"repo": "octo/other"})
+	result := deleteLabel(token[1])
+	require.True(t, result.IsError, "a token is only valid for the repository it was issued for")
+	assert.Equal(t, string(executor.KindValidation), result.StructuredContent.(map[string]any)["kind"])
+	assert.Empty(t, exec.Calls())
+}
+
+// TestConfirmDestructive_MapArgs checks that a confirm token can be redeemed
+// for a call with several map entries, whose flags must come out in the same
+// order on every call.
+func TestConfirmDestructive_MapArgs(t *testing.T) {
+	exec := executortest.NewRunner()
+	exec.SetConfirmDestructive(true)
+	server := mcp.NewServer(&mcp.Implementation{Name: "mcp-go-gh-test", Version: "test"}, nil)
+	RegisterAllTools(server, exec)
+	cs := connect(t, server)
+	ctx := context.Background()
+
+	args := map[string]any{
+		"endpoint":  "repos/octo/hello/issues",
+		"method":    "POST",
+		"raw_field": map[string]any{"title": "t", "body": "b", "labels": "bug", "milestone": "1"},
+	}
+	for range 10 {
+		result, err := cs.CallTool(ctx, &mcp.CallToolParams{Name: "gh_api_request", Arguments: args})
+		require.NoError(t, err)
+		message := result.StructuredContent.(map[string]any)["message"].(string)
+		token := regexp.MustCompile(`confirm_token "([^"]+)"`).FindStringSubmatch(message)
+		require.Len(t, token, 2)
+
+		result, err = cs.CallTool(ctx, &mcp.CallToolParams{Name: "gh_api_request", Arguments: map[string]any{
+			"endpoint":      args["endpoint"],
+			"method":        args["method"],
+			"raw_field":     args["raw_field"],
+			"confirm_token": token[1],
+		}})
+		require.NoError(t, err)
+		require.False(t, result.IsError, "%+v", result.Content)
+	}
+
+	call, ok := exec.LastCall()
+	require.True(t, ok)
+	assert.Equal(t, []string{"api", "repos/octo/hello/issues", "--method", "POST",
+		"-f", "body=b", "-f", "labels=bug", "-f", "milestone=1", "-f", "title=t"}, call.Argv)
+}
+
+// repeatFlag returns flag followed by each of values, as gh takes repeated
+// flags.
+func repeatFlag(flag string, values []string) []string {
+	var argv []string
+	for _, v := range values {
+		argv = append(argv, flag, v)
+	}
+	return argv
+}
+
+// requiredArgs returns representative values for the required properties of
+// the JSON schema of a tool's input.
+func requiredArgs(schema any) map[string]any {
+	s, _ := schema.(map[string]any)
+	properties, _ := s["properties"].(map[string]any)
+	required, _ := s["required"].([]any)
+
+	args := make(map[string]any)
+	for _, name := range required {
+		name := name.(string)
+		property, _ := properties[name].(map[string]any)
+		args[name] = sampleValue(name, property)
+	}
+	return args
+}
+
+// sampleValue returns a value valid for property.
+func sampleValue(name string, property map[string]any) any {
+	if enum, ok := property["enum"].([]any); ok && len(enum) > 0 {
+		return enum[0]
+	}
+	switch typ, _ := property["type"].(string); typ {
+	case "integer", "number":
+		return 1
+	case "boolean":
+		return true
+	case "array":
+		return []any{"sample-" + name}
+	case "object":
+		return map[string]any{"key": "value"}
+	}
+	return "sample-" + strings.ReplaceAll(name, "_", "-")
+}
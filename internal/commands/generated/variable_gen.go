@@ -2,15 +2,17 @@
 package generated
 
 import (
+	"cmp"
 	"context"
-	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // VariableSetArgs defines parameters for gh variable set
 type VariableSetArgs struct {
-	Body       string   `json:"body,omitempty" jsonschema:"The value for the variable (reads from stdin if not specified)"`
+	Body       string   `json:"body,omitempty" jsonschema:"The value for the variable, sent to gh on standard input"`
 	EnvFile    string   `json:"env_file,omitempty" jsonschema:"Load variable names and values from a dotenv-formatted file"`
 	Env        string   `json:"env,omitempty" jsonschema:"Set deployment environment variable"`
 	Org        string   `json:"org,omitempty" jsonschema:"Set organization variable"`
@@ -19,14 +21,47 @@ type VariableSetArgs struct {
 	Repo       string   `json:"repo,omitempty" jsonschema:"Select repository in OWNER/REPO format"`
 
 	VariableName string `json:"variable_name,omitempty" jsonschema:"Name of the variable (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// variableSetInputSpec holds the argument constraints for gh variable set
+var variableSetInputSpec = toolkit.InputSpec{
+	Required: []string{"variable_name"},
+	Properties: map[string]toolkit.Property{
+		"visibility": {Enum: []string{"all", "private", "selected"}},
+	},
 }
 
 // RegisterVariableSetTool registers the gh variable set tool
-func RegisterVariableSetTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterVariableSetTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_variable_set",
 		Description: "Create or update a variable",
+		InputSchema: toolkit.InputSchema[VariableSetArgs](variableSetInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Set Variable",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args VariableSetArgs) (*mcp.CallToolResult, any, error) {
+		if err := variableSetInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh variable set", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh variable set", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh variable set", nil, err)), nil, nil
+		}
+
 		cmd := []string{"variable", "set"}
 
 		// Add positional argument: variable_name
@@ -34,10 +69,6 @@ func RegisterVariableSetTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, args.VariableName)
 		}
 
-		if args.Body != "" {
-			cmd = append(cmd, "--body", args.Body)
-		}
-
 		if args.EnvFile != "" {
 			cmd = append(cmd, "--env-file", args.EnvFile)
 		}
@@ -62,16 +93,24 @@ func RegisterVariableSetTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_variable_set", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+			Stdin:       args.Body,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh variable set failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh variable set", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -79,18 +118,70 @@ func RegisterVariableSetTool(server *mcp.Server, exec *executor.Executor) {
 type VariableListArgs struct {
 	Env      string   `json:"env,omitempty" jsonschema:"List variables for an environment"`
 	Org      string   `json:"org,omitempty" jsonschema:"List variables for an organization"`
-	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: name,value,updatedAt)"`
 	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Repo     string   `json:"repo,omitempty" jsonschema:"Select repository in OWNER/REPO format"`
+	Format   string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// variableListInputSpec holds the argument constraints for gh variable list
+var variableListInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
+}
+
+// variableListOutputSpec describes the --json output of gh variable list
+var variableListOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "createdAt", Type: "string", Description: "When the variable was created"},
+		{Name: "name", Type: "string", Description: "Variable name"},
+		{Name: "numSelectedRepos", Type: "integer", Description: "Number of repositories with access"},
+		{Name: "selectedReposURL", Type: "string", Description: "API URL of the selected repositories"},
+		{Name: "updatedAt", Type: "string", Description: "When the variable was last updated"},
+		{Name: "value", Type: "string", Description: "Variable value"},
+		{Name: "visibility", Type: "string", Description: "Organization variable visibility"},
+	},
 }
 
+// variableListDefaultJSONFields are requested when no json fields are given
+var variableListDefaultJSONFields = []string{"name", "value", "updatedAt"}
+
 // RegisterVariableListTool registers the gh variable list tool
-func RegisterVariableListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterVariableListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_variable_list",
-		Description: "List variables",
+		Name:         "gh_variable_list",
+		Description:  "List variables",
+		InputSchema:  toolkit.InputSchema[VariableListArgs](variableListInputSpec),
+		OutputSchema: toolkit.OutputSchema(variableListOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Variables",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args VariableListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh variable list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh variable list", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" {
+			args.Json = variableListDefaultJSONFields
+		}
+
 		cmd := []string{"variable", "list"}
 
 		if args.Env != "" {
@@ -117,16 +208,23 @@ func RegisterVariableListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_variable_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh variable list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh variable list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -137,14 +235,44 @@ type VariableGetArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select repository in OWNER/REPO format"`
 
 	VariableName string `json:"variable_name,omitempty" jsonschema:"Name of the variable (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// variableGetInputSpec holds the argument constraints for gh variable get
+var variableGetInputSpec = toolkit.InputSpec{
+	Required: []string{"variable_name"},
 }
 
 // RegisterVariableGetTool registers the gh variable get tool
-func RegisterVariableGetTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterVariableGetTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_variable_get",
 		Description: "Get a variable value",
+		InputSchema: toolkit.InputSchema[VariableGetArgs](variableGetInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Get Variable",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args VariableGetArgs) (*mcp.CallToolResult, any, error) {
+		if err := variableGetInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh variable get", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh variable get", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh variable get", nil, err)), nil, nil
+		}
+
 		cmd := []string{"variable", "get"}
 
 		// Add positional argument: variable_name
@@ -164,16 +292,23 @@ func RegisterVariableGetTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_variable_get", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh variable get failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh variable get", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -184,14 +319,45 @@ type VariableDeleteArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select repository in OWNER/REPO format"`
 
 	VariableName string `json:"variable_name,omitempty" jsonschema:"Name of the variable (positional)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+	ConfirmToken   string `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"`
+}
+
+// variableDeleteInputSpec holds the argument constraints for gh variable delete
+var variableDeleteInputSpec = toolkit.InputSpec{
+	Required: []string{"variable_name"},
 }
 
 // RegisterVariableDeleteTool registers the gh variable delete tool
-func RegisterVariableDeleteTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterVariableDeleteTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_variable_delete",
 		Description: "Delete a variable",
+		InputSchema: toolkit.InputSchema[VariableDeleteArgs](variableDeleteInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Delete Variable",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args VariableDeleteArgs) (*mcp.CallToolResult, any, error) {
+		if err := variableDeleteInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh variable delete", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh variable delete", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh variable delete", nil, err)), nil, nil
+		}
+
 		cmd := []string{"variable", "delete"}
 
 		// Add positional argument: variable_name
@@ -211,15 +377,36 @@ func RegisterVariableDeleteTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		if exec.ConfirmDestructive() {
+			if result := toolkit.Confirm(ctx, req, "gh variable delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+
+		result, err := exec.Run(ctx, "gh_variable_delete", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh variable delete failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh variable delete", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterVariableTools registers the gh variable tools
+func RegisterVariableTools(server *mcp.Server, exec executor.Runner) {
+	RegisterVariableSetTool(server, exec)
+	RegisterVariableListTool(server, exec)
+	RegisterVariableGetTool(server, exec)
+	RegisterVariableDeleteTool(server, exec)
+}
@@ -0,0 +1,15 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestVariableArgv calls every gh variable tool with representative
+// arguments and compares the commands they run with testdata/variable.golden
+func TestVariableArgv(t *testing.T) {
+	checkArgvGolden(t, "variable", []argvCase{
+		{Tool: "gh_variable_set", Args: map[string]any{"variable_name": "sample-variable-name", "body": "sample-body", "env_file": "sample-env-file", "env": "sample-env", "org": "sample-org", "repos": []any{"sample-repos"}, "visibility": "all", "repo": "sample-repo"}},
+		{Tool: "gh_variable_list", Args: map[string]any{"env": "sample-env", "org": "sample-org", "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "repo": "sample-repo", "format": "json"}},
+		{Tool: "gh_variable_get", Args: map[string]any{"variable_name": "sample-variable-name", "env": "sample-env", "org": "sample-org", "repo": "sample-repo"}},
+		{Tool: "gh_variable_delete", Args: map[string]any{"variable_name": "sample-variable-name", "env": "sample-env", "org": "sample-org", "repo": "sample-repo"}},
+	})
+}
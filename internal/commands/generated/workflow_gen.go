@@ -2,28 +2,82 @@
 package generated
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"maps"
+	"slices"
 )
 
 // WorkflowListArgs defines parameters for gh workflow list
 type WorkflowListArgs struct {
 	All      bool     `json:"all,omitempty" jsonschema:"Include disabled workflows"`
 	Limit    int      `json:"limit,omitempty" jsonschema:"Maximum number of workflows to fetch"`
-	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields"`
+	Json     []string `json:"json,omitempty" jsonschema:"Output JSON with the specified fields (default: id,name,path,state)"`
 	Jq       string   `json:"jq,omitempty" jsonschema:"Filter JSON output using a jq expression"`
 	Template string   `json:"template,omitempty" jsonschema:"Format JSON output using a Go template"`
 	Repo     string   `json:"repo,omitempty" jsonschema:"Select repository"`
+	Format   string   `json:"format,omitempty" jsonschema:"Output format: json returns structured data, text returns gh's human-readable output"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// workflowListInputSpec holds the argument constraints for gh workflow list
+var workflowListInputSpec = toolkit.InputSpec{
+	Properties: map[string]toolkit.Property{
+		"format": {Enum: []string{"json", "text"}, Default: "json"},
+	},
+}
+
+// workflowListOutputSpec describes the --json output of gh workflow list
+var workflowListOutputSpec = toolkit.OutputSpec{
+	Array: true,
+	Fields: []toolkit.OutputField{
+		{Name: "id", Type: "integer", Description: "Workflow ID"},
+		{Name: "name", Type: "string", Description: "Workflow name"},
+		{Name: "path", Type: "string", Description: "Workflow file path"},
+		{Name: "state", Type: "string", Description: "Workflow state"},
+	},
 }
 
+// workflowListDefaultJSONFields are requested when no json fields are given
+var workflowListDefaultJSONFields = []string{"id", "name", "path", "state"}
+
 // RegisterWorkflowListTool registers the gh workflow list tool
-func RegisterWorkflowListTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterWorkflowListTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name:        "gh_workflow_list",
-		Description: "List workflow files",
+		Name:         "gh_workflow_list",
+		Description:  "List workflow files",
+		InputSchema:  toolkit.InputSchema[WorkflowListArgs](workflowListInputSpec),
+		OutputSchema: toolkit.OutputSchema(workflowListOutputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "List Workflows",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args WorkflowListArgs) (*mcp.CallToolResult, any, error) {
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh workflow list", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh workflow list", nil, err)), nil, nil
+		}
+
+		if len(args.Json) == 0 && args.Format != "text" {
+			args.Json = workflowListDefaultJSONFields
+		}
+
 		cmd := []string{"workflow", "list"}
 
 		if args.All {
@@ -50,16 +104,23 @@ func RegisterWorkflowListTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_workflow_list", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh workflow list failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh workflow list", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: result.Stdout},
-			},
-		}, nil, nil
+		if len(args.Json) > 0 && args.Format != "text" && args.Jq == "" && args.Template == "" {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
 	})
 }
 
@@ -71,14 +132,44 @@ type WorkflowViewArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	Workflow string `json:"workflow,omitempty" jsonschema:"Workflow ID, name, or filename (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// workflowViewInputSpec holds the argument constraints for gh workflow view
+var workflowViewInputSpec = toolkit.InputSpec{
+	Required: []string{"workflow"},
 }
 
 // RegisterWorkflowViewTool registers the gh workflow view tool
-func RegisterWorkflowViewTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterWorkflowViewTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_workflow_view",
 		Description: "View a workflow",
+		InputSchema: toolkit.InputSchema[WorkflowViewArgs](workflowViewInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "View Workflow",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args WorkflowViewArgs) (*mcp.CallToolResult, any, error) {
+		if err := workflowViewInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh workflow view", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh workflow view", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh workflow view", nil, err)), nil, nil
+		}
+
 		cmd := []string{"workflow", "view"}
 
 		// Add positional argument: workflow
@@ -102,16 +193,23 @@ func RegisterWorkflowViewTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_workflow_view", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh workflow view failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh workflow view", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -124,14 +222,44 @@ type WorkflowRunArgs struct {
 	Repo     string            `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	Workflow string `json:"workflow,omitempty" jsonschema:"Workflow ID, name, or filename (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// workflowRunInputSpec holds the argument constraints for gh workflow run
+var workflowRunInputSpec = toolkit.InputSpec{
+	Required: []string{"workflow"},
 }
 
 // RegisterWorkflowRunTool registers the gh workflow run tool
-func RegisterWorkflowRunTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterWorkflowRunTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_workflow_run",
 		Description: "Run a workflow",
+		InputSchema: toolkit.InputSchema[WorkflowRunArgs](workflowRunInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Run Workflow",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args WorkflowRunArgs) (*mcp.CallToolResult, any, error) {
+		if err := workflowRunInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh workflow run", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh workflow run", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh workflow run", nil, err)), nil, nil
+		}
+
 		cmd := []string{"workflow", "run"}
 
 		// Add positional argument: workflow
@@ -143,12 +271,12 @@ func RegisterWorkflowRunTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--ref", args.Ref)
 		}
 
-		for k, v := range args.Field {
-			cmd = append(cmd, "-F", fmt.Sprintf("%s=%s", k, v))
+		for _, k := range slices.Sorted(maps.Keys(args.Field)) {
+			cmd = append(cmd, "-F", fmt.Sprintf("%s=%s", k, args.Field[k]))
 		}
 
-		for k, v := range args.RawField {
-			cmd = append(cmd, "-f", fmt.Sprintf("%s=%s", k, v))
+		for _, k := range slices.Sorted(maps.Keys(args.RawField)) {
+			cmd = append(cmd, "-f", fmt.Sprintf("%s=%s", k, args.RawField[k]))
 		}
 
 		if args.Json {
@@ -159,16 +287,23 @@ func RegisterWorkflowRunTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_workflow_run", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh workflow run failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh workflow run", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -177,14 +312,44 @@ type WorkflowEnableArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	Workflow string `json:"workflow,omitempty" jsonschema:"Workflow ID, name, or filename (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// workflowEnableInputSpec holds the argument constraints for gh workflow enable
+var workflowEnableInputSpec = toolkit.InputSpec{
+	Required: []string{"workflow"},
 }
 
 // RegisterWorkflowEnableTool registers the gh workflow enable tool
-func RegisterWorkflowEnableTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterWorkflowEnableTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_workflow_enable",
 		Description: "Enable a workflow",
+		InputSchema: toolkit.InputSchema[WorkflowEnableArgs](workflowEnableInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Enable Workflow",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args WorkflowEnableArgs) (*mcp.CallToolResult, any, error) {
+		if err := workflowEnableInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh workflow enable", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh workflow enable", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh workflow enable", nil, err)), nil, nil
+		}
+
 		cmd := []string{"workflow", "enable"}
 
 		// Add positional argument: workflow
@@ -196,16 +361,23 @@ func RegisterWorkflowEnableTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_workflow_enable", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh workflow enable failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh workflow enable", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
 
@@ -214,14 +386,44 @@ type WorkflowDisableArgs struct {
 	Repo string `json:"repo,omitempty" jsonschema:"Select repository"`
 
 	Workflow string `json:"workflow,omitempty" jsonschema:"Workflow ID, name, or filename (positional argument)"`
+
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"`
+	Cwd            string `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"`
+}
+
+// workflowDisableInputSpec holds the argument constraints for gh workflow disable
+var workflowDisableInputSpec = toolkit.InputSpec{
+	Required: []string{"workflow"},
 }
 
 // RegisterWorkflowDisableTool registers the gh workflow disable tool
-func RegisterWorkflowDisableTool(server *mcp.Server, exec *executor.Executor) {
+func RegisterWorkflowDisableTool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "gh_workflow_disable",
 		Description: "Disable a workflow",
+		InputSchema: toolkit.InputSchema[WorkflowDisableArgs](workflowDisableInputSpec),
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Disable Workflow",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args WorkflowDisableArgs) (*mcp.CallToolResult, any, error) {
+		if err := workflowDisableInputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh workflow disable", err), nil, nil
+		}
+
+		defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh workflow disable", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh workflow disable", nil, err)), nil, nil
+		}
+
 		cmd := []string{"workflow", "disable"}
 
 		// Add positional argument: workflow
@@ -233,15 +435,31 @@ func RegisterWorkflowDisableTool(server *mcp.Server, exec *executor.Executor) {
 			cmd = append(cmd, "--repo", args.Repo)
 		}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+
+		result, err := exec.Run(ctx, "gh_workflow_disable", executor.Options{
+			Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh workflow disable failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh workflow disable", result, err)), nil, nil
 		}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
 	})
 }
+
+// RegisterWorkflowTools registers the gh workflow tools
+func RegisterWorkflowTools(server *mcp.Server, exec executor.Runner) {
+	RegisterWorkflowListTool(server, exec)
+	RegisterWorkflowViewTool(server, exec)
+	RegisterWorkflowRunTool(server, exec)
+	RegisterWorkflowEnableTool(server, exec)
+	RegisterWorkflowDisableTool(server, exec)
+}
@@ -0,0 +1,16 @@
+// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// TestWorkflowArgv calls every gh workflow tool with representative
+// arguments and compares the commands they run with testdata/workflow.golden
+func TestWorkflowArgv(t *testing.T) {
+	checkArgvGolden(t, "workflow", []argvCase{
+		{Tool: "gh_workflow_list", Args: map[string]any{"all": true, "limit": 1, "json": []any{"sample-json"}, "jq": "sample-jq", "template": "sample-template", "repo": "sample-repo", "format": "json"}},
+		{Tool: "gh_workflow_view", Args: map[string]any{"workflow": "sample-workflow", "ref": "sample-ref", "web": true, "yaml": true, "repo": "sample-repo"}},
+		{Tool: "gh_workflow_run", Args: map[string]any{"workflow": "sample-workflow", "ref": "sample-ref", "field": map[string]any{"key": "value"}, "raw_field": map[string]any{"key": "value"}, "json": true, "repo": "sample-repo"}},
+		{Tool: "gh_workflow_enable", Args: map[string]any{"workflow": "sample-workflow", "repo": "sample-repo"}},
+		{Tool: "gh_workflow_disable", Args: map[string]any{"workflow": "sample-workflow", "repo": "sample-repo"}},
+	})
+}
@@ -0,0 +1,85 @@
+package executor
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNoCredentials is returned for commands run without credentials by an
+// executor that requires them.
+var ErrNoCredentials = errors.New("this server requires each session to supply its own GitHub token")
+
+// Credentials replace the host's gh login for a command, so that it acts as
+// the user of one MCP session.
+type Credentials struct {
+	// Token authenticates gh, as both GH_TOKEN and GH_ENTERPRISE_TOKEN.
+	Token string
+
+	// ConfigDir is the GH_CONFIG_DIR private to the session, so that no
+	// gh configuration or state is shared with the host or other sessions.
+	ConfigDir string
+
+	// HomeDir is the HOME private to the session, so that git and ssh find
+	// none of the host user's configuration, credential helpers or keys.
+	HomeDir string
+}
+
+// hostCredentialEnv lists server variables that carry the host's GitHub
+// credentials or point gh and git at them. They are dropped for commands run
+// with session credentials.
+var hostCredentialEnv = map[string]bool{
+	"GH_TOKEN":                true,
+	"GITHUB_TOKEN":            true,
+	"GH_ENTERPRISE_TOKEN":     true,
+	"GITHUB_ENTERPRISE_TOKEN": true,
+	"GH_CONFIG_DIR":           true,
+	"SSH_AUTH_SOCK":           true,
+}
+
+// hostCredentialPrefix marks git's own variables, which can point git at the
+// host's configuration, askpass programs or SSH commands. They are dropped
+// for commands run with session credentials too.
+const hostCredentialPrefix = "GIT_"
+
+// env returns the variables that make gh, and the git and ssh it runs, use
+// c instead of anything of the host user's. ssh reads ~/.ssh from the
+// password database rather than HOME, so GIT_SSH_COMMAND points it at the
+// session's home explicitly, without the host's ssh config or agent.
+func (c *Credentials) env() []string {
+	sshDir := filepath.Join(c.HomeDir, ".ssh")
+	return []string{
+		"GH_TOKEN=" + c.Token,
+		"GH_ENTERPRISE_TOKEN=" + c.Token,
+		"GH_CONFIG_DIR=" + c.ConfigDir,
+		"HOME=" + c.HomeDir,
+		"USERPROFILE=" + c.HomeDir,
+		"XDG_CONFIG_HOME=" + filepath.Join(c.HomeDir, ".config"),
+		"XDG_CACHE_HOME=" + filepath.Join(c.HomeDir, ".cache"),
+		"XDG_DATA_HOME=" + filepath.Join(c.HomeDir, ".local", "share"),
+		"XDG_STATE_HOME=" + filepath.Join(c.HomeDir, ".local", "state"),
+		"GIT_CONFIG_NOSYSTEM=1",
+		"GIT_SSH_COMMAND=ssh -F none -o IdentityAgent=none -o BatchMode=yes" +
+			" -o IdentityFile=" + shellQuote(filepath.Join(sshDir, "id_ed25519")) +
+			" -o UserKnownHostsFile=" + shellQuote(filepath.Join(sshDir, "known_hosts")),
+	}
+}
+
+// shellQuote quotes s as a single word for the shell git runs
+// GIT_SSH_COMMAND with.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// withoutHostCredentials returns environ without the host's credentials.
+func withoutHostCredentials(environ []string) []string {
+	env := make([]string, 0, len(environ))
+	for _, kv := range environ {
+		name, _, _ := strings.Cut(kv, "=")
+		name = strings.ToUpper(name)
+		if !hostCredentialEnv[name] && !strings.HasPrefix(name, hostCredentialPrefix) {
+			env = append(env, kv)
+		}
+	}
+	return env
+}
@@ -0,0 +1,110 @@
+package executor
+
+import (
+	"runtime"
+	"strings"
+)
+
+// nonInteractiveEnv is forced on every gh invocation, so that neither gh nor
+// the git it starts ever waits on a prompt or pager, and gh never mixes
+// colors or update notices into output.
+var nonInteractiveEnv = []string{
+	"GH_PROMPT_DISABLED=1",
+	"GH_PAGER=cat",
+	"GH_NO_UPDATE_NOTIFIER=1",
+	"GH_NO_EXTENSION_UPDATE_NOTIFIER=1",
+	"GIT_TERMINAL_PROMPT=0",
+	"GCM_INTERACTIVE=never",
+	"NO_COLOR=1",
+	"CLICOLOR=0",
+}
+
+// allowedEnv lists the server environment variables passed through to gh.
+// Everything else is dropped.
+var allowedEnv = map[string]bool{
+	// Process basics
+	"PATH": true, "HOME": true, "USER": true, "LOGNAME": true,
+	"TMPDIR": true, "TMP": true, "TEMP": true, "TZ": true, "LANG": true,
+	"XDG_CONFIG_HOME": true, "XDG_CACHE_HOME": true, "XDG_DATA_HOME": true,
+	"XDG_STATE_HOME": true, "XDG_RUNTIME_DIR": true,
+
+	// Windows
+	"SYSTEMROOT": true, "WINDIR": true, "COMSPEC": true, "PATHEXT": true,
+	"USERPROFILE": true, "APPDATA": true, "LOCALAPPDATA": true, "PROGRAMDATA": true,
+
+	// Authentication and networking
+	"GITHUB_TOKEN": true, "GITHUB_ENTERPRISE_TOKEN": true, "SSH_AUTH_SOCK": true,
+	"HTTP_PROXY": true, "HTTPS_PROXY": true, "NO_PROXY": true,
+	"http_proxy": true, "https_proxy": true, "no_proxy": true,
+	"SSL_CERT_FILE": true, "SSL_CERT_DIR": true,
+
+	// Opening a browser (--web tools, gh browse): the browser command gh
+	// falls back to after GH_BROWSER and git's web.browser, and the
+	// display and session bus that xdg-open needs on Linux desktops
+	"BROWSER": true, "DISPLAY": true, "WAYLAND_DISPLAY": true,
+	"XAUTHORITY": true, "DBUS_SESSION_BUS_ADDRESS": true,
+}
+
+// allowedEnvPrefixes lists prefixes of variables passed through to gh:
+// gh's own settings, git's, and locale settings.
+var allowedEnvPrefixes = []string{"GH_", "GIT_", "LC_"}
+
+// deniedEnv lists variables that would make gh behave interactively even
+// though they match an allowed prefix.
+var deniedEnv = map[string]bool{
+	"GH_FORCE_TTY": true,
+}
+
+// childEnv returns the environment for a gh process: the allowed variables
+// of environ, then the extra variables chosen for the call, then the
+// non-interactive settings. Each replaces earlier values of its variables,
+// and the non-interactive settings cannot be overridden.
+func childEnv(environ, extra []string) []string {
+	forced := envNames(nonInteractiveEnv)
+	overridden := envNames(extra)
+
+	env := make([]string, 0, len(environ)+len(extra)+len(nonInteractiveEnv))
+	for _, kv := range environ {
+		name, _, ok := strings.Cut(kv, "=")
+		if ok && !forced[name] && !overridden[name] && envAllowed(name) {
+			env = append(env, kv)
+		}
+	}
+	for _, kv := range extra {
+		name, _, _ := strings.Cut(kv, "=")
+		if !forced[name] {
+			env = append(env, kv)
+		}
+	}
+	return append(env, nonInteractiveEnv...)
+}
+
+// envNames returns the set of variable names assigned in env.
+func envNames(env []string) map[string]bool {
+	names := make(map[string]bool, len(env))
+	for _, kv := range env {
+		name, _, _ := strings.Cut(kv, "=")
+		names[name] = true
+	}
+	return names
+}
+
+// envAllowed reports whether the variable name is passed through to gh.
+func envAllowed(name string) bool {
+	// Environment variable names are case-insensitive on Windows.
+	if runtime.GOOS == "windows" {
+		name = strings.ToUpper(name)
+	}
+	if deniedEnv[name] {
+		return false
+	}
+	if allowedEnv[name] {
+		return true
+	}
+	for _, prefix := range allowedEnvPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+package executor
+
+import (
+	"context"
+	osexec "os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChildEnv(t *testing.T) {
+	env := childEnv([]string{
+		"PATH=/usr/bin",
+		"HOME=/home/octo",
+		"GH_TOKEN=gho_abc",
+		"GH_HOST=github.example.com",
+		"GH_PAGER=less",
+		"GH_FORCE_TTY=1",
+		"GIT_SSH_COMMAND=ssh -i key",
+		"GIT_TERMINAL_PROMPT=1",
+		"LC_ALL=C",
+		"AWS_SECRET_ACCESS_KEY=hunter2",
+		"TERM=xterm-256color",
+		"CLICOLOR_FORCE=1",
+	}, nil)
+
+	assert.Equal(t, []string{
+		"PATH=/usr/bin",
+		"HOME=/home/octo",
+		"GH_TOKEN=gho_abc",
+		"GH_HOST=github.example.com",
+		"GIT_SSH_COMMAND=ssh -i key",
+		"LC_ALL=C",
+		"GH_PROMPT_DISABLED=1",
+		"GH_PAGER=cat",
+		"GH_NO_UPDATE_NOTIFIER=1",
+		"GH_NO_EXTENSION_UPDATE_NOTIFIER=1",
+		"GIT_TERMINAL_PROMPT=0",
+		"GCM_INTERACTIVE=never",
+		"NO_COLOR=1",
+		"CLICOLOR=0",
+	}, env)
+}
+
+func TestChildEnv_Browser(t *testing.T) {
+	env := childEnv([]string{
+		"GH_BROWSER=firefox",
+		"BROWSER=chromium",
+		"DISPLAY=:0",
+		"WAYLAND_DISPLAY=wayland-0",
+		"XAUTHORITY=/run/user/1000/xauth",
+		"DBUS_SESSION_BUS_ADDRESS=unix:path=/run/user/1000/bus",
+	}, nil)
+
+	assert.Subset(t, env, []string{
+		"GH_BROWSER=firefox",
+		"BROWSER=chromium",
+		"DISPLAY=:0",
+		"WAYLAND_DISPLAY=wayland-0",
+		"XAUTHORITY=/run/user/1000/xauth",
+		"DBUS_SESSION_BUS_ADDRESS=unix:path=/run/user/1000/bus",
+	}, "tools that open a browser can reach the desktop")
+}
+
+func TestChildEnv_Extra(t *testing.T) {
+	env := childEnv([]string{
+		"PATH=/usr/bin",
+		"GH_REPO=octo/server",
+		"GH_HOST=github.com",
+	}, []string{
+		"GH_REPO=octo/session",
+		"GH_PAGER=less",
+	})
+
+	assert.Equal(t, []string{
+		"PATH=/usr/bin",
+		"GH_HOST=github.com",
+		"GH_REPO=octo/session",
+		"GH_PROMPT_DISABLED=1",
+		"GH_PAGER=cat",
+		"GH_NO_UPDATE_NOTIFIER=1",
+		"GH_NO_EXTENSION_UPDATE_NOTIFIER=1",
+		"GIT_TERMINAL_PROMPT=0",
+		"GCM_INTERACTIVE=never",
+		"NO_COLOR=1",
+		"CLICOLOR=0",
+	}, env)
+}
+
+func TestExecutor_Environment(t *testing.T) {
+	envPath, err := osexec.LookPath("env")
+	if err != nil {
+		t.Skip("env not available")
+	}
+	t.Setenv("GH_PAGER", "less")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "hunter2")
+
+	envExec := &Executor{logger: createTestLogger(), ghPath: envPath, timeout: time.Minute}
+	result, err := envExec.Execute(context.Background())
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(result.Stdout), "\n")
+	assert.Contains(t, lines, "GH_PROMPT_DISABLED=1")
+	assert.Contains(t, lines, "GH_PAGER=cat")
+	assert.Contains(t, lines, "GIT_TERMINAL_PROMPT=0")
+	assert.Contains(t, lines, "GCM_INTERACTIVE=never")
+	assert.NotContains(t, lines, "GH_PAGER=less")
+	assert.NotContains(t, lines, "AWS_SECRET_ACCESS_KEY=hunter2")
+}
+
+func TestExecutor_Credentials(t *testing.T) {
+	envPath, err := osexec.LookPath("env")
+	if err != nil {
+		t.Skip("env not available")
+	}
+	t.Setenv("GH_TOKEN", "host-token")
+	t.Setenv("GITHUB_TOKEN", "host-token")
+	t.Setenv("GH_CONFIG_DIR", "/home/host/.config/gh")
+	t.Setenv("SSH_AUTH_SOCK", "/tmp/ssh-agent.sock")
+	t.Setenv("GH_HOST", "github.com")
+	t.Setenv("HOME", "/home/host")
+	t.Setenv("XDG_CONFIG_HOME", "/home/host/.config")
+	t.Setenv("GIT_ASKPASS", "/usr/bin/host-askpass")
+	t.Setenv("GIT_CONFIG_GLOBAL", "/home/host/.gitconfig")
+
+	envExec := &Executor{logger: createTestLogger(), ghPath: envPath, timeout: time.Minute}
+
+	t.Run("replaces the host login", func(t *testing.T) {
+		creds := &Credentials{Token: "session-token", ConfigDir: "/tmp/session/.config/gh", HomeDir: "/tmp/session"}
+		result, err := envExec.ExecuteWithOptions(context.Background(), Options{Credentials: creds})
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(result.Stdout), "\n")
+		assert.Contains(t, lines, "GH_TOKEN=session-token")
+		assert.Contains(t, lines, "GH_ENTERPRISE_TOKEN=session-token")
+		assert.Contains(t, lines, "GH_CONFIG_DIR=/tmp/session/.config/gh")
+		assert.Contains(t, lines, "HOME=/tmp/session")
+		assert.Contains(t, lines, "XDG_CONFIG_HOME=/tmp/session/.config")
+		assert.Contains(t, lines, "GIT_CONFIG_NOSYSTEM=1")
+		assert.Contains(t, lines, "GIT_SSH_COMMAND=ssh -F none -o IdentityAgent=none -o BatchMode=yes -o IdentityFile='/tmp/session/.ssh/id_ed25519' -o UserKnownHostsFile='/tmp/session/.ssh/known_hosts'")
+		assert.Contains(t, lines, "GH_HOST=github.com", "other gh settings are kept")
+		assert.NotContains(t, result.Stdout, "host-token")
+		assert.NotContains(t, result.Stdout, "/home/host", "nothing points at the host user's home")
+		assert.NotContains(t, result.Stdout, "host-askpass")
+		assert.NotContains(t, result.Stdout, "SSH_AUTH_SOCK")
+	})
+
+	t.Run("uses the host login without credentials", func(t *testing.T) {
+		result, err := envExec.Execute(context.Background())
+		require.NoError(t, err)
+		assert.Contains(t, strings.Split(strings.TrimSpace(result.Stdout), "\n"), "GH_TOKEN=host-token")
+	})
+
+	t.Run("refuses commands without credentials when they are required", func(t *testing.T) {
+		strict := &Executor{logger: createTestLogger(), ghPath: envPath, timeout: time.Minute}
+		strict.SetRequireCredentials(true)
+
+		_, err := strict.Execute(context.Background())
+		assert.ErrorIs(t, err, ErrNoCredentials)
+
+		_, err = strict.ExecuteWithOptions(context.Background(), Options{Credentials: &Credentials{Token: "t", ConfigDir: t.TempDir(), HomeDir: t.TempDir()}})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,49 @@
+package executor
+
+import "regexp"
+
+// ErrorKind classifies why a gh command failed.
+type ErrorKind string
+
+// Error kinds reported for failed gh commands.
+const (
+	KindAuthRequired   ErrorKind = "auth_required"
+	KindNotFound       ErrorKind = "not_found"
+	KindValidation     ErrorKind = "validation"
+	KindRateLimited    ErrorKind = "rate_limited"
+	KindNetwork        ErrorKind = "network"
+	KindTimeout        ErrorKind = "timeout"
+	KindCanceled       ErrorKind = "canceled"
+	KindPromptRequired ErrorKind = "prompt_required"
+	KindUnknown        ErrorKind = "unknown"
+
+	// KindConfirmationRequired and KindDeclined report destructive commands
+	// that were not run because the user has not approved them.
+	KindConfirmationRequired ErrorKind = "confirmation_required"
+	KindDeclined             ErrorKind = "declined"
+)
+
+// errorPatterns maps gh stderr patterns to error kinds. Patterns are checked
+// in order, so more specific kinds (rate limiting is reported as HTTP 403)
+// come before broader ones.
+var errorPatterns = []struct {
+	kind    ErrorKind
+	pattern *regexp.Regexp
+}{
+	{KindRateLimited, regexp.MustCompile(`(?i)rate limit|HTTP 429|too many requests`)},
+	{KindAuthRequired, regexp.MustCompile(`(?i)gh auth login|HTTP 401|bad credentials|authentication required|requires authentication|not logged in|must authenticate|GH_TOKEN`)},
+	{KindNetwork, regexp.MustCompile(`(?i)dial tcp|no such host|connection refused|connection reset|i/o timeout|TLS handshake|network is unreachable|error connecting to`)},
+	{KindNotFound, regexp.MustCompile(`(?i)HTTP 404|not found|could not resolve to an?|no such file or directory`)},
+	{KindPromptRequired, regexp.MustCompile(`(?i)when not running interactively|prompts? (are )?disabled|could not prompt`)},
+	{KindValidation, regexp.MustCompile(`(?i)HTTP 422|validation failed|unknown flag|unknown shorthand flag|unknown command|invalid argument|invalid value|flag needs an argument|required flag|accepts \d+ arg|requires at least \d+ arg|must be one of|cannot be used|only one of`)},
+}
+
+// Classify derives an ErrorKind from the stderr output of a failed gh command.
+func Classify(stderr string) ErrorKind {
+	for _, p := range errorPatterns {
+		if p.pattern.MatchString(stderr) {
+			return p.kind
+		}
+	}
+	return KindUnknown
+}
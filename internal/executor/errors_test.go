@@ -0,0 +1,87 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name   string
+		stderr string
+		want   ErrorKind
+	}{
+		{
+			name:   "not logged in",
+			stderr: "To get started with GitHub CLI, please run:  gh auth login",
+			want:   KindAuthRequired,
+		},
+		{
+			name:   "bad credentials",
+			stderr: "HTTP 401: Bad credentials (https://api.github.com/graphql)",
+			want:   KindAuthRequired,
+		},
+		{
+			name:   "missing repository",
+			stderr: "GraphQL: Could not resolve to a Repository with the name 'octo/missing'. (repository)",
+			want:   KindNotFound,
+		},
+		{
+			name:   "missing resource",
+			stderr: "HTTP 404: Not Found (https://api.github.com/repos/octo/missing)",
+			want:   KindNotFound,
+		},
+		{
+			name:   "unknown flag",
+			stderr: "unknown flag: --bogus\n\nUsage:  gh pr list [flags]",
+			want:   KindValidation,
+		},
+		{
+			name:   "unprocessable entity",
+			stderr: "HTTP 422: Validation Failed (https://api.github.com/repos/octo/repo/labels)",
+			want:   KindValidation,
+		},
+		{
+			name:   "rate limit reported as 403",
+			stderr: "HTTP 403: API rate limit exceeded for user ID 1.",
+			want:   KindRateLimited,
+		},
+		{
+			name:   "dns failure",
+			stderr: "error connecting to api.github.com\ncheck your internet connection",
+			want:   KindNetwork,
+		},
+		{
+			name:   "connection refused",
+			stderr: "Post \"https://api.github.com/graphql\": dial tcp 127.0.0.1:443: connect: connection refused",
+			want:   KindNetwork,
+		},
+		{
+			name:   "missing flags without a terminal",
+			stderr: "must provide `--title` and `--body` (or `--fill` or `fill-first` or `--fillverbose`) when not running interactively",
+			want:   KindPromptRequired,
+		},
+		{
+			name:   "confirmation without a terminal",
+			stderr: "--yes required when not running interactively",
+			want:   KindPromptRequired,
+		},
+		{
+			name:   "unrecognized output",
+			stderr: "something unexpected happened",
+			want:   KindUnknown,
+		},
+		{
+			name:   "empty stderr",
+			stderr: "",
+			want:   KindUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Classify(tt.stderr))
+		})
+	}
+}
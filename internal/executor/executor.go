@@ -3,64 +3,69 @@ package executor
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"os"
 	"os/exec"
 	"strings"
+	"sync"
 	"time"
 )
 
-// sensitiveCommands identifies command groups that handle secret data.
-var sensitiveCommands = map[string]bool{
-	"secret":   true,
-	"variable": true,
+// sanitizeArgs returns a sanitized copy of args suitable for logging,
+// using the default redaction rules.
+func sanitizeArgs(args []string) string {
+	return defaultRedactor.Args(args)
 }
 
-// sensitiveFlags identifies flags whose values should be redacted in logs.
-var sensitiveFlags = map[string]bool{
-	"--body": true,
+// Executor handles execution of gh CLI commands.
+type Executor struct {
+	logger     *slog.Logger
+	ghPath     string
+	workdir    string
+	timeout    time.Duration
+	maxTimeout time.Duration
+	killGrace  time.Duration
+	redactor   *Redactor
+	redaction  RedactionMode
+	readOnly   bool
+	confirm    bool
+	middleware []ToolMiddleware
+
+	requireCredentials bool
 }
 
-// sanitizeArgs returns a sanitized copy of args suitable for logging.
-// For commands that handle secrets, sensitive flag values are redacted.
-func sanitizeArgs(args []string) string {
-	if len(args) == 0 {
-		return ""
-	}
+// Options customizes a single command execution.
+type Options struct {
+	// Timeout overrides the executor's default timeout when positive.
+	// It is capped by the executor's maximum timeout, if one is set.
+	Timeout time.Duration
 
-	isSensitive := false
-	for _, arg := range args {
-		if sensitiveCommands[arg] {
-			isSensitive = true
-			break
-		}
-	}
+	// Dir is the directory gh runs in, from which it infers the current
+	// repository. It overrides the executor's working directory when set.
+	Dir string
 
-	if !isSensitive {
-		return strings.Join(args, " ")
-	}
+	// Credentials, when set, replace the host's gh login and configuration.
+	Credentials *Credentials
 
-	sanitized := make([]string, 0, len(args))
-	skipNext := false
-	for _, arg := range args {
-		if skipNext {
-			sanitized = append(sanitized, "[REDACTED]")
-			skipNext = false
-			continue
-		}
-		sanitized = append(sanitized, arg)
-		if sensitiveFlags[arg] {
-			skipNext = true
-		}
-	}
-	return strings.Join(sanitized, " ")
-}
+	// Env holds extra NAME=value variables for gh, such as GH_REPO. They
+	// replace server values but not the non-interactive settings.
+	Env []string
 
-// Executor handles execution of gh CLI commands.
-type Executor struct {
-	logger  *slog.Logger
-	ghPath  string
-	timeout time.Duration
+	// Stdin is piped to gh on standard input. Secrets passed this way never
+	// appear in argv, where other local users could read them.
+	Stdin string
+
+	// SensitiveOutput marks commands whose stdout is itself a secret (e.g.
+	// gh auth token). It is withheld entirely in RedactAll mode.
+	SensitiveOutput bool
+
+	// OnLine, if set, is called with each line gh writes to stdout or
+	// stderr while the command runs. Calls are never concurrent. Output is
+	// still captured in the Result.
+	OnLine func(line string)
 }
 
 // Result contains the output of a command execution.
@@ -68,6 +73,10 @@ type Result struct {
 	Stdout   string
 	Stderr   string
 	ExitCode int
+
+	// Dir is the directory gh ran in, or "" for the server's working
+	// directory.
+	Dir string
 }
 
 // New creates a new Executor instance.
@@ -79,33 +88,74 @@ func New(logger *slog.Logger) (*Executor, error) {
 	}
 
 	return &Executor{
-		ghPath:  ghPath,
-		timeout: 5 * time.Minute, // Default timeout
-		logger:  logger,
+		ghPath:    ghPath,
+		timeout:   5 * time.Minute, // Default timeout
+		killGrace: defaultKillGrace,
+		logger:    logger,
+		redactor:  defaultRedactor,
+		redaction: RedactLogs,
 	}, nil
 }
 
 // Execute runs a gh command with the given arguments.
 func (e *Executor) Execute(ctx context.Context, args ...string) (*Result, error) {
+	return e.ExecuteWithOptions(ctx, Options{}, args...)
+}
+
+// ExecuteWithOptions runs a gh command with the given arguments and options.
+func (e *Executor) ExecuteWithOptions(ctx context.Context, opts Options, args ...string) (*Result, error) {
+	if e.requireCredentials && opts.Credentials == nil {
+		return nil, ErrNoCredentials
+	}
+
 	// Apply timeout
-	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	timeout := e.effectiveTimeout(opts.Timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	// Build command
+	// Build command. On cancellation or timeout the whole process tree is
+	// stopped, not just gh itself.
 	cmd := exec.CommandContext(ctx, e.ghPath, args...)
+	cleanup := stopProcessTree(cmd, e.effectiveKillGrace())
+	environ, extra := os.Environ(), opts.Env
+	if opts.Credentials != nil {
+		environ = withoutHostCredentials(environ)
+		extra = append(append([]string{}, extra...), opts.Credentials.env()...)
+	}
+	cmd.Env = childEnv(environ, extra)
+	cmd.Dir = e.workdir
+	if opts.Dir != "" {
+		cmd.Dir = opts.Dir
+	}
 
 	// Capture stdout and stderr
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
+	var stdoutLines, stderrLines *lineWriter
+	if opts.OnLine != nil {
+		stdoutLines, stderrLines = e.streamLines(opts)
+		cmd.Stdout = io.MultiWriter(&stdout, stdoutLines)
+		cmd.Stderr = io.MultiWriter(&stderr, stderrLines)
+	}
+	if opts.Stdin != "" {
+		cmd.Stdin = strings.NewReader(opts.Stdin)
+	}
 
 	// Log command execution (with sensitive values redacted)
 	e.logger.Info("executing gh command",
 		"command", "gh",
-		"args", sanitizeArgs(args))
+		"args", e.logArgs(args),
+		"dir", cmd.Dir,
+		"stdin", opts.Stdin != "")
 
 	// Execute command
-	err := cmd.Run()
+	err := waitError(cmd, cmd.Run())
+	cleanup()
+	if opts.OnLine != nil {
+		stdoutLines.Flush()
+		stderrLines.Flush()
+	}
 
 	// Get exit code
 	exitCode := 0
@@ -117,30 +167,187 @@ func (e *Executor) Execute(ctx context.Context, args ...string) (*Result, error)
 		Stdout:   stdout.String(),
 		Stderr:   stderr.String(),
 		ExitCode: exitCode,
+		Dir:      cmd.Dir,
+	}
+	if e.redaction == RedactAll {
+		result = e.redactResult(result, opts.SensitiveOutput)
+	}
+
+	if err != nil && errors.Is(ctx.Err(), context.Canceled) {
+		e.logger.Warn("gh command canceled",
+			"exit_code", exitCode,
+			"args", e.logArgs(args))
+		return result, fmt.Errorf("gh command canceled: %w", ctx.Err())
 	}
 
 	if err != nil {
 		e.logger.Error("gh command failed",
 			"error", err,
-			"stderr", result.Stderr,
+			"stderr", e.logText(result.Stderr),
 			"exit_code", exitCode,
-			"args", sanitizeArgs(args))
+			"args", e.logArgs(args))
 
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return result, fmt.Errorf("gh command timed out after %s: %w", timeout, ctx.Err())
+		}
 		return result, fmt.Errorf("gh command failed (exit %d): %s", exitCode, result.Stderr)
 	}
 
 	e.logger.Debug("gh command succeeded",
 		"exit_code", exitCode,
-		"args", sanitizeArgs(args))
+		"args", e.logArgs(args))
 
 	return result, nil
 }
 
+// streamLines returns writers reporting stdout and stderr lines to
+// opts.OnLine, with the same redaction applied as to the Result.
+func (e *Executor) streamLines(opts Options) (stdout, stderr *lineWriter) {
+	onLine := opts.OnLine
+	if e.redaction == RedactAll {
+		onLine = func(line string) {
+			opts.OnLine(e.redactor.Text(line))
+		}
+	}
+
+	var mu sync.Mutex
+	stdout = &lineWriter{mu: &mu, onLine: onLine}
+	stderr = &lineWriter{mu: &mu, onLine: onLine}
+	if e.redaction == RedactAll && opts.SensitiveOutput {
+		stdout.onLine = func(string) {}
+	}
+	return stdout, stderr
+}
+
+// logArgs renders args for the log, redacted unless redaction is off.
+func (e *Executor) logArgs(args []string) string {
+	if e.redaction == RedactOff {
+		return strings.Join(args, " ")
+	}
+	return e.redactor.Args(args)
+}
+
+// RedactArgs formats argv with secret values redacted, whatever the
+// redaction mode, for records that must never hold secrets such as the
+// audit log.
+func (e *Executor) RedactArgs(argv []string) string {
+	return e.redactor.Args(argv)
+}
+
+// logText renders command output for the log, redacted unless redaction is off.
+func (e *Executor) logText(s string) string {
+	if e.redaction == RedactOff {
+		return s
+	}
+	return e.redactor.Text(s)
+}
+
+// redactResult returns a copy of result with secrets redacted from its
+// output. The stdout of sensitive commands is withheld entirely.
+func (e *Executor) redactResult(result *Result, sensitiveOutput bool) *Result {
+	redactedResult := &Result{
+		Stdout:   e.redactor.Text(result.Stdout),
+		Stderr:   e.redactor.Text(result.Stderr),
+		ExitCode: result.ExitCode,
+		Dir:      result.Dir,
+	}
+	if sensitiveOutput && result.Stdout != "" {
+		redactedResult.Stdout = redacted
+	}
+	return redactedResult
+}
+
+// effectiveTimeout resolves the timeout for a command, preferring the
+// requested timeout over the default and capping it at the maximum.
+func (e *Executor) effectiveTimeout(requested time.Duration) time.Duration {
+	timeout := e.timeout
+	if requested > 0 {
+		timeout = requested
+	}
+	if e.maxTimeout > 0 && timeout > e.maxTimeout {
+		e.logger.Warn("capping gh command timeout",
+			"requested", timeout,
+			"max", e.maxTimeout)
+		timeout = e.maxTimeout
+	}
+	return timeout
+}
+
+// effectiveKillGrace returns how long a canceled command may take to exit
+// before it is killed.
+func (e *Executor) effectiveKillGrace() time.Duration {
+	if e.killGrace <= 0 {
+		return defaultKillGrace
+	}
+	return e.killGrace
+}
+
 // SetTimeout changes the default command timeout.
 func (e *Executor) SetTimeout(timeout time.Duration) {
 	e.timeout = timeout
 }
 
+// SetMaxTimeout caps the timeout of every command, including per-command
+// overrides. A zero value removes the cap.
+func (e *Executor) SetMaxTimeout(timeout time.Duration) {
+	e.maxTimeout = timeout
+}
+
+// SetWorkdir changes the directory commands run in when a call does not
+// choose one. An empty directory uses the server's working directory.
+func (e *Executor) SetWorkdir(dir string) {
+	e.workdir = dir
+}
+
+// Workdir returns the directory commands run in when a call does not choose
+// one.
+func (e *Executor) Workdir() string {
+	if e.workdir != "" {
+		return e.workdir
+	}
+	dir, _ := os.Getwd()
+	return dir
+}
+
+// SetRedactionMode changes where secrets are redacted.
+func (e *Executor) SetRedactionMode(mode RedactionMode) {
+	e.redaction = mode
+}
+
+// SetRedactor replaces the rules used to redact secrets.
+func (e *Executor) SetRedactor(redactor *Redactor) {
+	e.redactor = redactor
+}
+
+// SetReadOnly puts the executor in read-only mode, in which tools constrain
+// their arguments so that they cannot change anything.
+func (e *Executor) SetReadOnly(readOnly bool) {
+	e.readOnly = readOnly
+}
+
+// ReadOnly reports whether the executor is in read-only mode.
+func (e *Executor) ReadOnly() bool {
+	return e.readOnly
+}
+
+// SetConfirmDestructive makes destructive tools ask the user to approve
+// each command before it runs.
+func (e *Executor) SetConfirmDestructive(confirm bool) {
+	e.confirm = confirm
+}
+
+// ConfirmDestructive reports whether destructive tools need approval.
+func (e *Executor) ConfirmDestructive() bool {
+	return e.confirm
+}
+
+// SetRequireCredentials makes the executor refuse commands that do not
+// carry session credentials, so that no caller can act as the host's gh
+// login.
+func (e *Executor) SetRequireCredentials(require bool) {
+	e.requireCredentials = require
+}
+
 // GetGhPath returns the path to the gh binary.
 func (e *Executor) GetGhPath() string {
 	return e.ghPath
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	osexec "os/exec"
 	"strings"
 	"testing"
 	"time"
@@ -71,6 +72,32 @@ func TestExecutor_SetTimeout(t *testing.T) {
 	assert.Equal(t, newTimeout, exec.timeout, "timeout should be updated")
 }
 
+func TestExecutor_EffectiveTimeout(t *testing.T) {
+	logger := createTestLogger()
+	exec, err := New(logger)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		maxTimeout time.Duration
+		requested  time.Duration
+		want       time.Duration
+	}{
+		{name: "default timeout", want: 5 * time.Minute},
+		{name: "requested timeout", requested: 30 * time.Second, want: 30 * time.Second},
+		{name: "requested timeout below max", maxTimeout: time.Hour, requested: 30 * time.Minute, want: 30 * time.Minute},
+		{name: "requested timeout capped at max", maxTimeout: time.Hour, requested: 2 * time.Hour, want: time.Hour},
+		{name: "default timeout capped at max", maxTimeout: time.Minute, want: time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec.SetMaxTimeout(tt.maxTimeout)
+			assert.Equal(t, tt.want, exec.effectiveTimeout(tt.requested))
+		})
+	}
+}
+
 func TestExecutor_Execute(t *testing.T) {
 	logger := createTestLogger()
 	exec, err := New(logger)
@@ -119,6 +146,49 @@ func TestExecutor_Execute(t *testing.T) {
 		assert.NotNil(t, result, "result should not be nil")
 	})
 
+	t.Run("pipes stdin to the command", func(t *testing.T) {
+		catPath, err := osexec.LookPath("cat")
+		if err != nil {
+			t.Skip("cat not available")
+		}
+		catExec := &Executor{logger: logger, ghPath: catPath, timeout: time.Minute}
+
+		result, err := catExec.ExecuteWithOptions(context.Background(), Options{Stdin: "s3cr3t"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "s3cr3t", result.Stdout)
+	})
+
+	t.Run("runs in the requested directory", func(t *testing.T) {
+		pwdPath, err := osexec.LookPath("pwd")
+		if err != nil {
+			t.Skip("pwd not available")
+		}
+		workdir, callDir := t.TempDir(), t.TempDir()
+		pwdExec := &Executor{logger: logger, ghPath: pwdPath, timeout: time.Minute, redactor: defaultRedactor}
+		pwdExec.SetWorkdir(workdir)
+
+		result, err := pwdExec.Execute(context.Background(), "-P")
+		require.NoError(t, err)
+		assertSameDir(t, workdir, strings.TrimSpace(result.Stdout))
+		assert.Equal(t, workdir, result.Dir)
+
+		result, err = pwdExec.ExecuteWithOptions(context.Background(), Options{Dir: callDir}, "-P")
+		require.NoError(t, err)
+		assertSameDir(t, callDir, strings.TrimSpace(result.Stdout))
+		assert.Equal(t, callDir, result.Dir)
+	})
+
+	t.Run("respects per-command timeout", func(t *testing.T) {
+		ctx := context.Background()
+		result, err := exec.ExecuteWithOptions(ctx, Options{Timeout: time.Nanosecond}, "--version")
+
+		require.Error(t, err, "ExecuteWithOptions should return error when timeout is exceeded")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, err.Error(), "timed out after 1ns")
+		assert.NotNil(t, result, "result should not be nil")
+	})
+
 	t.Run("handles commands with multiple arguments", func(t *testing.T) {
 		ctx := context.Background()
 		result, _ := exec.Execute(ctx, "auth", "status")
@@ -262,3 +332,14 @@ func BenchmarkExecutor_Execute(b *testing.B) {
 		_, _ = exec.Execute(ctx, "--version")
 	}
 }
+
+// assertSameDir asserts that two paths name the same directory, ignoring
+// symlinks such as macOS's /var -> /private/var.
+func assertSameDir(t *testing.T, want, got string) {
+	t.Helper()
+	wantInfo, err := os.Stat(want)
+	require.NoError(t, err)
+	gotInfo, err := os.Stat(got)
+	require.NoError(t, err)
+	assert.True(t, os.SameFile(wantInfo, gotInfo), "want %s, got %s", want, got)
+}
@@ -0,0 +1,128 @@
+// Package executortest provides a scripted executor.Runner, for testing
+// tools without a gh binary.
+package executortest
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+)
+
+// Call is one command a tool ran through a Runner.
+type Call struct {
+	Tool    string
+	Argv    []string
+	Options executor.Options
+}
+
+// Response is what a Runner answers a command with.
+type Response struct {
+	Result *executor.Result
+	Err    error
+}
+
+// Runner is an executor.Runner that records every command instead of
+// running it, and answers with the responses scripted for its tool. Tools
+// without a script get an empty, successful result. It is safe for
+// concurrent use.
+type Runner struct {
+	readOnly bool
+	confirm  bool
+	workdir  string
+
+	mu        sync.Mutex
+	calls     []Call
+	responses map[string][]Response
+}
+
+var _ executor.Runner = (*Runner)(nil)
+
+// NewRunner returns a Runner with nothing scripted.
+func NewRunner() *Runner {
+	return &Runner{responses: make(map[string][]Response)}
+}
+
+// Respond scripts the answers to the next commands of tool, in order. The
+// last answer is repeated once the others are used up.
+func (r *Runner) Respond(tool string, responses ...Response) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.responses[tool] = append(r.responses[tool], responses...)
+}
+
+// RespondStdout scripts tool to succeed with stdout.
+func (r *Runner) RespondStdout(tool, stdout string) {
+	r.Respond(tool, Response{Result: &executor.Result{Stdout: stdout}})
+}
+
+// SetReadOnly sets what ReadOnly reports.
+func (r *Runner) SetReadOnly(readOnly bool) {
+	r.readOnly = readOnly
+}
+
+// SetConfirmDestructive sets what ConfirmDestructive reports.
+func (r *Runner) SetConfirmDestructive(confirm bool) {
+	r.confirm = confirm
+}
+
+// SetWorkdir sets what Workdir reports.
+func (r *Runner) SetWorkdir(dir string) {
+	r.workdir = dir
+}
+
+// Run records the command and returns the next response scripted for tool.
+func (r *Runner) Run(_ context.Context, tool string, opts executor.Options, argv ...string) (*executor.Result, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Tool: tool, Argv: slices.Clone(argv), Options: opts})
+
+	responses := r.responses[tool]
+	if len(responses) == 0 {
+		return &executor.Result{}, nil
+	}
+	if len(responses) > 1 {
+		r.responses[tool] = responses[1:]
+	}
+	return responses[0].Result, responses[0].Err
+}
+
+// ReadOnly reports what SetReadOnly set.
+func (r *Runner) ReadOnly() bool {
+	return r.readOnly
+}
+
+// ConfirmDestructive reports what SetConfirmDestructive set.
+func (r *Runner) ConfirmDestructive() bool {
+	return r.confirm
+}
+
+// Workdir reports what SetWorkdir set.
+func (r *Runner) Workdir() string {
+	return r.workdir
+}
+
+// Calls returns the commands run so far, oldest first.
+func (r *Runner) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.calls)
+}
+
+// LastCall returns the most recent command, and false if none has run.
+func (r *Runner) LastCall() (Call, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.calls) == 0 {
+		return Call{}, false
+	}
+	return r.calls[len(r.calls)-1], true
+}
+
+// Reset forgets the recorded commands, keeping the scripted responses.
+func (r *Runner) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
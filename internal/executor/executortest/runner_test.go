@@ -0,0 +1,47 @@
+package executortest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+)
+
+func TestRunner(t *testing.T) {
+	ctx := context.Background()
+	runner := NewRunner()
+
+	_, ok := runner.LastCall()
+	assert.False(t, ok)
+
+	result, err := runner.Run(ctx, "gh_pr_list", executor.Options{Dir: "/work"}, "pr", "list")
+	require.NoError(t, err)
+	assert.Equal(t, &executor.Result{}, result, "unscripted tools succeed with no output")
+
+	failure := errors.New("gh command failed (exit 1)")
+	runner.RespondStdout("gh_pr_view", "first")
+	runner.Respond("gh_pr_view", Response{Result: &executor.Result{Stderr: "not found", ExitCode: 1}, Err: failure})
+
+	result, err = runner.Run(ctx, "gh_pr_view", executor.Options{}, "pr", "view", "1")
+	require.NoError(t, err)
+	assert.Equal(t, "first", result.Stdout)
+	for range 2 {
+		result, err = runner.Run(ctx, "gh_pr_view", executor.Options{}, "pr", "view", "2")
+		assert.ErrorIs(t, err, failure, "the last response repeats")
+		assert.Equal(t, 1, result.ExitCode)
+	}
+
+	calls := runner.Calls()
+	require.Len(t, calls, 4)
+	assert.Equal(t, Call{Tool: "gh_pr_list", Argv: []string{"pr", "list"}, Options: executor.Options{Dir: "/work"}}, calls[0])
+	last, ok := runner.LastCall()
+	require.True(t, ok)
+	assert.Equal(t, []string{"pr", "view", "2"}, last.Argv)
+
+	runner.Reset()
+	assert.Empty(t, runner.Calls())
+}
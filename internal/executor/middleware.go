@@ -0,0 +1,94 @@
+package executor
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+// Runner runs the gh commands of tools. Generated tools depend on it rather
+// than on *Executor, so that tests and embedders can substitute their own.
+type Runner interface {
+	// Run runs the gh command argv for the named tool.
+	Run(ctx context.Context, tool string, opts Options, argv ...string) (*Result, error)
+
+	// ReadOnly reports whether tools must constrain their arguments so
+	// that they cannot change anything.
+	ReadOnly() bool
+
+	// ConfirmDestructive reports whether destructive tools need the user's
+	// approval before running.
+	ConfirmDestructive() bool
+
+	// Workdir returns the directory commands run in when Options.Dir is
+	// empty.
+	Workdir() string
+}
+
+var _ Runner = (*Executor)(nil)
+
+// ToolHandler runs the gh command argv on behalf of the named tool.
+type ToolHandler func(ctx context.Context, tool string, argv []string) (*Result, error)
+
+// ToolMiddleware wraps the gh command of every tool, for cross-cutting
+// behavior such as policy checks, metrics, caching or argument rewriting.
+// A middleware may inspect or rewrite argv, return a result of its own
+// without calling next, or inspect the result of next.
+type ToolMiddleware func(next ToolHandler) ToolHandler
+
+// Use adds middleware around the commands tools run through Run. The first
+// middleware added is the outermost.
+func (e *Executor) Use(middleware ...ToolMiddleware) {
+	e.middleware = append(e.middleware, middleware...)
+}
+
+// Run runs the gh command argv for the named tool through the executor's
+// middleware, and then ExecuteWithOptions.
+func (e *Executor) Run(ctx context.Context, tool string, opts Options, argv ...string) (*Result, error) {
+	handler := ToolHandler(func(ctx context.Context, _ string, argv []string) (*Result, error) {
+		return e.ExecuteWithOptions(ctx, opts, argv...)
+	})
+	for _, m := range slices.Backward(e.middleware) {
+		handler = m(handler)
+	}
+	return handler(ctx, tool, argv)
+}
+
+// LogToolCalls returns middleware that logs the outcome and duration of
+// every tool's gh command.
+func LogToolCalls(logger *slog.Logger) ToolMiddleware {
+	return func(next ToolHandler) ToolHandler {
+		return func(ctx context.Context, tool string, argv []string) (*Result, error) {
+			start := time.Now()
+			result, err := next(ctx, tool, argv)
+
+			attrs := []any{"tool", tool, "duration", time.Since(start), "failed", err != nil}
+			if result != nil {
+				attrs = append(attrs, "exit_code", result.ExitCode)
+			}
+			logger.Info("tool call finished", attrs...)
+			return result, err
+		}
+	}
+}
+
+// attemptKey is the context key of the function told about attempted
+// commands.
+type attemptKey struct{}
+
+// WithAttemptObserver returns a context in which tools report to observe
+// each gh command they are about to run and the directory it runs in,
+// before confirming it. Unlike ToolMiddleware, observe also learns of
+// commands that never reach the Runner, such as ones the user declined.
+func WithAttemptObserver(ctx context.Context, observe func(argv []string, dir string)) context.Context {
+	return context.WithValue(ctx, attemptKey{}, observe)
+}
+
+// Attempt reports that a tool is about to run argv in dir to the observer
+// of ctx, if any.
+func Attempt(ctx context.Context, argv []string, dir string) {
+	if observe, ok := ctx.Value(attemptKey{}).(func([]string, string)); ok {
+		observe(argv, dir)
+	}
+}
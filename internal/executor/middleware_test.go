@@ -0,0 +1,85 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	osexec "os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutor_Run(t *testing.T) {
+	echoPath, err := osexec.LookPath("echo")
+	if err != nil {
+		t.Skip("echo not available")
+	}
+	ctx := context.Background()
+
+	t.Run("runs the command without middleware", func(t *testing.T) {
+		echoExec := &Executor{logger: createTestLogger(), ghPath: echoPath, timeout: time.Minute, redactor: defaultRedactor}
+		result, err := echoExec.Run(ctx, "gh_pr_list", Options{}, "pr", "list")
+		require.NoError(t, err)
+		assert.Equal(t, "pr list\n", result.Stdout)
+	})
+
+	t.Run("applies middleware in order", func(t *testing.T) {
+		echoExec := &Executor{logger: createTestLogger(), ghPath: echoPath, timeout: time.Minute, redactor: defaultRedactor}
+		var calls []string
+		trace := func(name string) ToolMiddleware {
+			return func(next ToolHandler) ToolHandler {
+				return func(ctx context.Context, tool string, argv []string) (*Result, error) {
+					calls = append(calls, name+" "+tool)
+					return next(ctx, tool, argv)
+				}
+			}
+		}
+		echoExec.Use(trace("outer"), trace("inner"))
+
+		_, err := echoExec.Run(ctx, "gh_pr_list", Options{}, "pr", "list")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"outer gh_pr_list", "inner gh_pr_list"}, calls)
+	})
+
+	t.Run("middleware can rewrite argv", func(t *testing.T) {
+		echoExec := &Executor{logger: createTestLogger(), ghPath: echoPath, timeout: time.Minute, redactor: defaultRedactor}
+		echoExec.Use(func(next ToolHandler) ToolHandler {
+			return func(ctx context.Context, tool string, argv []string) (*Result, error) {
+				return next(ctx, tool, append(argv, "--limit", "5"))
+			}
+		})
+
+		result, err := echoExec.Run(ctx, "gh_pr_list", Options{}, "pr", "list")
+		require.NoError(t, err)
+		assert.Equal(t, "pr list --limit 5\n", result.Stdout)
+	})
+
+	t.Run("middleware can answer without running gh", func(t *testing.T) {
+		echoExec := &Executor{logger: createTestLogger(), ghPath: "/nonexistent/gh", timeout: time.Minute, redactor: defaultRedactor}
+		echoExec.Use(func(ToolHandler) ToolHandler {
+			return func(context.Context, string, []string) (*Result, error) {
+				return &Result{Stdout: "cached"}, nil
+			}
+		})
+
+		result, err := echoExec.Run(ctx, "gh_pr_list", Options{}, "pr", "list")
+		require.NoError(t, err)
+		assert.Equal(t, "cached", result.Stdout)
+	})
+}
+
+func TestLogToolCalls(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	handler := LogToolCalls(logger)(func(context.Context, string, []string) (*Result, error) {
+		return &Result{ExitCode: 0}, nil
+	})
+
+	_, err := handler(context.Background(), "gh_pr_list", []string{"pr", "list"})
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), `msg="tool call finished" tool=gh_pr_list`)
+	assert.Contains(t, buf.String(), "failed=false exit_code=0")
+}
@@ -0,0 +1,48 @@
+package executor
+
+import (
+	"errors"
+	"os/exec"
+	"time"
+)
+
+// defaultKillGrace is how long a canceled command's process tree has to exit
+// after SIGTERM before it is killed.
+const defaultKillGrace = 5 * time.Second
+
+// stopProcessTree arranges for the whole process tree of cmd to be stopped
+// when its context is done: it is sent SIGTERM, and SIGKILL once grace has
+// passed. The returned function must be called after cmd.Wait; it cancels
+// the pending SIGKILL if nothing in the tree is left running.
+func stopProcessTree(cmd *exec.Cmd, grace time.Duration) (cleanup func()) {
+	setProcessGroup(cmd)
+
+	var killTimer *time.Timer
+	cmd.Cancel = func() error {
+		killTimer = time.AfterFunc(grace, func() {
+			_ = killProcessTree(cmd)
+		})
+		return terminateProcessTree(cmd)
+	}
+	// Grandchildren may keep gh's output pipes open after gh itself exits;
+	// stop waiting for them once the grace period is over. See waitError.
+	cmd.WaitDelay = grace
+
+	return func() {
+		// cmd.Wait does not return before cmd.Cancel has, so killTimer
+		// is safe to read here.
+		if killTimer != nil && !processTreeAlive(cmd) {
+			killTimer.Stop()
+		}
+	}
+}
+
+// waitError returns the error of running cmd, ignoring exec.ErrWaitDelay
+// when gh itself succeeded: a grandchild such as a credential helper that
+// still held gh's output open does not make the command fail.
+func waitError(cmd *exec.Cmd, err error) error {
+	if errors.Is(err, exec.ErrWaitDelay) && cmd.ProcessState != nil && cmd.ProcessState.Success() {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,84 @@
+package executor
+
+import (
+	"context"
+	"os"
+	osexec "os/exec"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// processRunning reports whether pid is running, treating zombies as exited.
+func processRunning(t *testing.T, pid int) bool {
+	t.Helper()
+	stat, err := os.ReadFile("/proc/" + strconv.Itoa(pid) + "/stat")
+	if err != nil {
+		return false
+	}
+	// The state follows the parenthesized command name.
+	fields := strings.Fields(string(stat[strings.LastIndexByte(string(stat), ')')+1:]))
+	return len(fields) > 0 && fields[0] != "Z"
+}
+
+func TestExecutor_CancelKillsProcessTree(t *testing.T) {
+	shPath, err := osexec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh not available")
+	}
+
+	tests := []struct {
+		name   string
+		script string
+	}{
+		// The child prints the pid of a grandchild and waits for it.
+		{name: "terminates grandchildren", script: `sleep 30 & echo $!; wait`},
+		{name: "kills grandchildren ignoring SIGTERM", script: `trap '' TERM; sh -c "trap '' TERM; sleep 30" & echo $!; wait`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shExec := &Executor{logger: createTestLogger(), ghPath: shPath, timeout: time.Minute, killGrace: 200 * time.Millisecond, redactor: defaultRedactor, redaction: RedactLogs}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			pids := make(chan int, 1)
+			onLine := func(line string) {
+				pid, err := strconv.Atoi(line)
+				if err == nil {
+					pids <- pid
+					cancel()
+				}
+			}
+
+			result, err := shExec.ExecuteWithOptions(ctx, Options{OnLine: onLine}, "-c", tt.script)
+
+			require.Error(t, err)
+			assert.ErrorIs(t, err, context.Canceled)
+			assert.Contains(t, err.Error(), "gh command canceled")
+			assert.NotNil(t, result)
+
+			pid := <-pids
+			assert.Eventually(t, func() bool { return !processRunning(t, pid) },
+				2*time.Second, 20*time.Millisecond, "grandchild %d should be stopped", pid)
+		})
+	}
+}
+
+func TestExecutor_GrandchildHoldingOutput(t *testing.T) {
+	shPath, err := osexec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh not available")
+	}
+	shExec := &Executor{logger: createTestLogger(), ghPath: shPath, timeout: time.Minute, killGrace: 100 * time.Millisecond, redactor: defaultRedactor, redaction: RedactLogs}
+
+	// The child exits at once, but leaves a grandchild holding its stdout.
+	result, err := shExec.ExecuteWithOptions(context.Background(), Options{}, "-c", "sleep 5 & echo done")
+
+	require.NoError(t, err, "a successful command succeeds even when its output stays open")
+	assert.Equal(t, "done\n", result.Stdout)
+	assert.Equal(t, 0, result.ExitCode)
+}
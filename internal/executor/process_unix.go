@@ -0,0 +1,39 @@
+//go:build unix
+
+package executor
+
+import (
+	"errors"
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup starts the command in a new process group, so that gh and
+// everything it spawns (git, ssh, extensions) can be signaled together.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// terminateProcessTree asks the command's process group to exit.
+func terminateProcessTree(cmd *exec.Cmd) error {
+	return signalProcessGroup(cmd, syscall.SIGTERM)
+}
+
+// killProcessTree forcibly kills the command's process group.
+func killProcessTree(cmd *exec.Cmd) error {
+	return signalProcessGroup(cmd, syscall.SIGKILL)
+}
+
+// processTreeAlive reports whether any process in the command's process
+// group is still running.
+func processTreeAlive(cmd *exec.Cmd) bool {
+	return signalProcessGroup(cmd, 0) == nil
+}
+
+func signalProcessGroup(cmd *exec.Cmd, sig syscall.Signal) error {
+	if cmd.Process == nil {
+		return errors.New("process not started")
+	}
+	// A negative pid signals the whole group led by the process.
+	return syscall.Kill(-cmd.Process.Pid, sig)
+}
@@ -0,0 +1,40 @@
+//go:build windows
+
+package executor
+
+import (
+	"errors"
+	"os/exec"
+	"strconv"
+	"syscall"
+)
+
+// setProcessGroup starts the command in a new process group, so that gh and
+// everything it spawns (git, ssh, extensions) can be stopped together.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+// terminateProcessTree asks the command and its descendants to exit.
+func terminateProcessTree(cmd *exec.Cmd) error {
+	return taskkill(cmd)
+}
+
+// killProcessTree forcibly kills the command and its descendants.
+func killProcessTree(cmd *exec.Cmd) error {
+	return taskkill(cmd, "/F")
+}
+
+// processTreeAlive reports whether the command's process tree may still be
+// running. Windows offers no cheap check, so the forced kill always runs.
+func processTreeAlive(*exec.Cmd) bool {
+	return true
+}
+
+func taskkill(cmd *exec.Cmd, flags ...string) error {
+	if cmd.Process == nil {
+		return errors.New("process not started")
+	}
+	args := append([]string{"/T", "/PID", strconv.Itoa(cmd.Process.Pid)}, flags...)
+	return exec.Command("taskkill", args...).Run()
+}
@@ -0,0 +1,181 @@
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// redacted replaces secret values in logs, errors and results.
+const redacted = "[REDACTED]"
+
+// RedactionMode controls where secrets are redacted.
+type RedactionMode string
+
+// Redaction modes, from least to most aggressive.
+const (
+	// RedactOff disables redaction entirely.
+	RedactOff RedactionMode = "off"
+	// RedactLogs redacts arguments and output written to the server log.
+	RedactLogs RedactionMode = "logs"
+	// RedactAll additionally redacts the output, errors and results returned
+	// to the client, and withholds output of commands marked sensitive.
+	RedactAll RedactionMode = "all"
+)
+
+// ParseRedactionMode parses a redaction mode name.
+func ParseRedactionMode(s string) (RedactionMode, error) {
+	switch mode := RedactionMode(s); mode {
+	case RedactOff, RedactLogs, RedactAll:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid redaction mode %q: must be one of off, logs, all", s)
+	}
+}
+
+// ParseRedactionPatterns compiles extra regular expressions whose matches
+// are redacted, as passed with --redact-pattern.
+func ParseRedactionPatterns(exprs []string) ([]*regexp.Regexp, error) {
+	patterns := make([]*regexp.Regexp, 0, len(exprs))
+	for _, expr := range exprs {
+		if expr == "" {
+			return nil, errors.New("invalid redaction pattern: empty")
+		}
+		pattern, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction pattern %q: %w", expr, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, nil
+}
+
+// RedactionRules configures what a Redactor treats as secret.
+type RedactionRules struct {
+	// CommandFlags lists, per command group, flags whose values are secret
+	// within that group (e.g. --body for gh secret).
+	CommandFlags map[string][]string
+	// Flags lists flags whose values are secret in every command.
+	Flags []string
+	// Headers lists HTTP headers whose values are secret when passed with
+	// -H/--header (e.g. gh api -H "Authorization: token ...").
+	Headers []string
+	// Patterns match secrets anywhere in arguments and output.
+	Patterns []*regexp.Regexp
+}
+
+// DefaultRedactionRules returns rules covering the secrets gh handles:
+// secret and variable values, credential headers, GitHub tokens and
+// private keys.
+func DefaultRedactionRules() RedactionRules {
+	return RedactionRules{
+		CommandFlags: map[string][]string{
+			"secret":   {"--body"},
+			"variable": {"--body"},
+		},
+		Headers: []string{"Authorization", "Cookie", "X-GitHub-Token"},
+		Patterns: []*regexp.Regexp{
+			regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`),
+			regexp.MustCompile(`\bgithub_pat_[A-Za-z0-9_]{22,}\b`),
+			regexp.MustCompile(`(?i)\bauthorization:\s*(?:bearer|token|basic)\s+\S+`),
+			regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`),
+		},
+	}
+}
+
+// Redactor hides secrets in gh arguments and output.
+type Redactor struct {
+	commandFlags map[string]map[string]bool
+	flags        map[string]bool
+	headers      map[string]bool
+	patterns     []*regexp.Regexp
+}
+
+// NewRedactor creates a Redactor applying the given rules.
+func NewRedactor(rules RedactionRules) *Redactor {
+	r := &Redactor{
+		commandFlags: make(map[string]map[string]bool, len(rules.CommandFlags)),
+		flags:        make(map[string]bool, len(rules.Flags)),
+		headers:      make(map[string]bool, len(rules.Headers)),
+		patterns:     rules.Patterns,
+	}
+	for command, flags := range rules.CommandFlags {
+		r.commandFlags[command] = make(map[string]bool, len(flags))
+		for _, flag := range flags {
+			r.commandFlags[command][flag] = true
+		}
+	}
+	for _, flag := range rules.Flags {
+		r.flags[flag] = true
+	}
+	for _, header := range rules.Headers {
+		r.headers[strings.ToLower(header)] = true
+	}
+	return r
+}
+
+// defaultRedactor applies DefaultRedactionRules.
+var defaultRedactor = NewRedactor(DefaultRedactionRules())
+
+// Args returns args joined for display, with secret values redacted.
+func (r *Redactor) Args(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+
+	commandFlags := r.commandFlags[args[0]]
+	isSecretFlag := func(flag string) bool {
+		return r.flags[flag] || commandFlags[flag]
+	}
+
+	sanitized := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		if flag, _, ok := strings.Cut(arg, "="); ok && isSecretFlag(flag) {
+			sanitized = append(sanitized, flag+"="+redacted)
+			continue
+		}
+		if flag, value, ok := strings.Cut(arg, "="); ok && isHeaderFlag(flag) {
+			sanitized = append(sanitized, flag+"="+r.header(value))
+			continue
+		}
+
+		sanitized = append(sanitized, r.Text(arg))
+		if i+1 >= len(args) {
+			continue
+		}
+		switch {
+		case isSecretFlag(arg):
+			sanitized = append(sanitized, redacted)
+			i++
+		case isHeaderFlag(arg):
+			sanitized = append(sanitized, r.header(args[i+1]))
+			i++
+		}
+	}
+	return strings.Join(sanitized, " ")
+}
+
+// Text redacts secrets matched by the rule patterns.
+func (r *Redactor) Text(s string) string {
+	for _, pattern := range r.patterns {
+		s = pattern.ReplaceAllString(s, redacted)
+	}
+	return s
+}
+
+// header redacts the value of a "Name: value" header if Name is secret.
+func (r *Redactor) header(h string) string {
+	name, _, ok := strings.Cut(h, ":")
+	if ok && r.headers[strings.ToLower(strings.TrimSpace(name))] {
+		return name + ": " + redacted
+	}
+	return r.Text(h)
+}
+
+// isHeaderFlag reports whether flag sets an HTTP header in gh api.
+func isHeaderFlag(flag string) bool {
+	return flag == "-H" || flag == "--header"
+}
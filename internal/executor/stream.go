@@ -0,0 +1,52 @@
+package executor
+
+import (
+	"bytes"
+	"sync"
+)
+
+// lineWriter calls onLine for every complete line written to it. Writers
+// sharing a mutex never call onLine concurrently, so one callback can safely
+// consume both stdout and stderr.
+type lineWriter struct {
+	mu      *sync.Mutex
+	onLine  func(line string)
+	pending []byte
+}
+
+// Write implements io.Writer.
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.pending = append(w.pending, p...)
+	for {
+		i := bytes.IndexByte(w.pending, '\n')
+		if i < 0 {
+			break
+		}
+		w.emit(w.pending[:i])
+		w.pending = w.pending[i+1:]
+	}
+	return len(p), nil
+}
+
+// Flush reports a trailing line that did not end in a newline.
+func (w *lineWriter) Flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.pending) > 0 {
+		w.emit(w.pending)
+		w.pending = nil
+	}
+}
+
+// emit reports a line, dropping carriage returns and blank lines.
+func (w *lineWriter) emit(line []byte) {
+	line = bytes.TrimRight(line, "\r")
+	if len(bytes.TrimSpace(line)) == 0 {
+		return
+	}
+	w.onLine(string(line))
+}
@@ -0,0 +1,79 @@
+package executor
+
+import (
+	"context"
+	osexec "os/exec"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineWriter(t *testing.T) {
+	var lines []string
+	w := &lineWriter{mu: &sync.Mutex{}, onLine: func(line string) {
+		lines = append(lines, line)
+	}}
+
+	_, _ = w.Write([]byte("Refreshing run status every 3 seconds.\r\n✓ build"))
+	_, _ = w.Write([]byte(" (ID 1)\n\n"))
+	_, _ = w.Write([]byte("X test"))
+	assert.Equal(t, []string{"Refreshing run status every 3 seconds.", "✓ build (ID 1)"}, lines)
+
+	w.Flush()
+	assert.Equal(t, []string{"Refreshing run status every 3 seconds.", "✓ build (ID 1)", "X test"}, lines)
+
+	w.Flush()
+	assert.Len(t, lines, 3, "flushing twice reports nothing new")
+}
+
+func TestExecutor_Streaming(t *testing.T) {
+	catPath, err := osexec.LookPath("cat")
+	if err != nil {
+		t.Skip("cat not available")
+	}
+	exec := &Executor{logger: createTestLogger(), ghPath: catPath, timeout: time.Minute, redactor: defaultRedactor, redaction: RedactLogs}
+
+	t.Run("reports lines while capturing output", func(t *testing.T) {
+		var lines []string
+		result, err := exec.ExecuteWithOptions(context.Background(), Options{
+			Stdin:  "cloning\nreceiving objects\ndone",
+			OnLine: func(line string) { lines = append(lines, line) },
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cloning", "receiving objects", "done"}, lines)
+		assert.Equal(t, "cloning\nreceiving objects\ndone", result.Stdout)
+	})
+
+	t.Run("redacts streamed lines in all mode", func(t *testing.T) {
+		exec.SetRedactionMode(RedactAll)
+		defer exec.SetRedactionMode(RedactLogs)
+
+		var lines []string
+		_, err := exec.ExecuteWithOptions(context.Background(), Options{
+			Stdin:  "token " + testToken + "\n",
+			OnLine: func(line string) { lines = append(lines, line) },
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"token [REDACTED]"}, lines)
+	})
+
+	t.Run("withholds sensitive stdout in all mode", func(t *testing.T) {
+		exec.SetRedactionMode(RedactAll)
+		defer exec.SetRedactionMode(RedactLogs)
+
+		var lines []string
+		_, err := exec.ExecuteWithOptions(context.Background(), Options{
+			Stdin:           "opaque-token\n",
+			SensitiveOutput: true,
+			OnLine:          func(line string) { lines = append(lines, line) },
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, lines)
+	})
+}
@@ -0,0 +1,208 @@
+// Package httpserver serves the MCP server over streamable HTTP or SSE, so
+// that several clients can share one server.
+package httpserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/auth"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Transports selectable with --transport.
+const (
+	TransportStdio = "stdio"
+	TransportHTTP  = "http"
+	TransportSSE   = "sse"
+)
+
+// Endpoint paths.
+const (
+	HealthPath = "/healthz"
+	MCPPath    = "/mcp"
+	SSEPath    = "/sse"
+)
+
+// shutdownTimeout bounds how long Serve waits for open requests on shutdown.
+const shutdownTimeout = 10 * time.Second
+
+// Config configures the HTTP listener.
+type Config struct {
+	// Transport is TransportHTTP (streamable HTTP, served on MCPPath) or
+	// TransportSSE (served on SSEPath).
+	Transport string
+
+	// Addr is the address to listen on, e.g. 127.0.0.1:8080.
+	Addr string
+
+	// AuthToken, when set, is the bearer token clients must send in the
+	// Authorization header.
+	AuthToken string
+
+	// TLSCertFile and TLSKeyFile serve HTTPS. ClientCAFile additionally
+	// requires clients to present a certificate signed by one of its CAs.
+	TLSCertFile  string
+	TLSKeyFile   string
+	ClientCAFile string
+
+	// InsecureNoAuth allows listening beyond loopback without an auth
+	// token or client certificates, for servers behind an authenticating
+	// proxy.
+	InsecureNoAuth bool
+}
+
+// Validate rejects incomplete configurations.
+func (c Config) Validate() error {
+	if c.Transport != TransportHTTP && c.Transport != TransportSSE {
+		return fmt.Errorf("transport %q must be http or sse", c.Transport)
+	}
+	if c.Addr == "" {
+		return errors.New("listen address is required")
+	}
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return errors.New("TLS certificate and key must be given together")
+	}
+	if c.ClientCAFile != "" && c.TLSCertFile == "" {
+		return errors.New("client CA requires a TLS certificate and key")
+	}
+	if c.AuthToken == "" && c.ClientCAFile == "" && !c.InsecureNoAuth && !isLoopback(c.Addr) {
+		return fmt.Errorf("listening on %s beyond localhost requires an auth token or a client CA (or --insecure-no-auth)", c.Addr)
+	}
+	return nil
+}
+
+// isLoopback reports whether addr only accepts connections from the local
+// host. An empty host listens on every interface.
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// Handler returns the HTTP handler for server: the MCP endpoint of the
+// configured transport, behind bearer authentication when an auth token is
+// set, and an unauthenticated health check.
+//
+// Every client gets its own MCP session, and session state such as context
+// defaults and confirm tokens is keyed by it, so clients never see each
+// other's state.
+func Handler(server *mcp.Server, cfg Config, logger *slog.Logger) http.Handler {
+	getServer := func(*http.Request) *mcp.Server { return server }
+
+	var handler http.Handler
+	path := MCPPath
+	if cfg.Transport == TransportSSE {
+		handler = mcp.NewSSEHandler(getServer, nil)
+		path = SSEPath
+	} else {
+		handler = mcp.NewStreamableHTTPHandler(getServer, &mcp.StreamableHTTPOptions{Logger: logger})
+	}
+	if cfg.AuthToken != "" {
+		handler = auth.RequireBearerToken(verifyToken(cfg.AuthToken), nil)(handler)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	mux.HandleFunc("GET "+HealthPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte("ok\n"))
+	})
+	return mux
+}
+
+// verifyToken accepts only the configured bearer token.
+func verifyToken(want string) auth.TokenVerifier {
+	return func(_ context.Context, token string, _ *http.Request) (*auth.TokenInfo, error) {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(want)) != 1 {
+			return nil, auth.ErrInvalidToken
+		}
+		return &auth.TokenInfo{Expiration: time.Now().Add(time.Hour)}, nil
+	}
+}
+
+// Serve listens on cfg.Addr and serves server until ctx is done.
+func Serve(ctx context.Context, server *mcp.Server, cfg Config, logger *slog.Logger) error {
+	tlsConfig, err := cfg.tlsConfig()
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", cfg.Addr)
+	if err != nil {
+		return fmt.Errorf("listen on %s: %w", cfg.Addr, err)
+	}
+
+	httpServer := &http.Server{
+		Handler:           Handler(server, cfg, logger),
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+		ErrorLog:          slog.NewLogLogger(logger.Handler(), slog.LevelWarn),
+	}
+
+	errc := make(chan error, 1)
+	go func() {
+		if tlsConfig != nil {
+			errc <- httpServer.ServeTLS(listener, cfg.TLSCertFile, cfg.TLSKeyFile)
+		} else {
+			errc <- httpServer.Serve(listener)
+		}
+	}()
+
+	logger.Info("listening for MCP clients",
+		"transport", cfg.Transport,
+		"addr", listener.Addr().String(),
+		"tls", tlsConfig != nil,
+		"client_certs", cfg.ClientCAFile != "",
+		"bearer_auth", cfg.AuthToken != "")
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("shutting down: %w", err)
+	}
+	return nil
+}
+
+// tlsConfig returns the TLS settings for cfg, or nil to serve plain HTTP.
+func (c Config) tlsConfig() (*tls.Config, error) {
+	if c.TLSCertFile == "" {
+		return nil, nil
+	}
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if c.ClientCAFile != "" {
+		// #nosec G304 -- the path comes from the server's own configuration
+		pem, err := os.ReadFile(c.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading client CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("client CA %s holds no PEM certificates", c.ClientCAFile)
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
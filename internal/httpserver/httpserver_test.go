@@ -0,0 +1,240 @@
+package httpserver
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr string
+	}{
+		{name: "http on loopback", cfg: Config{Transport: TransportHTTP, Addr: "127.0.0.1:8080"}},
+		{name: "http on IPv6 loopback", cfg: Config{Transport: TransportHTTP, Addr: "[::1]:8080"}},
+		{name: "http on localhost", cfg: Config{Transport: TransportHTTP, Addr: "localhost:8080"}},
+		{name: "http with auth token", cfg: Config{Transport: TransportHTTP, Addr: ":8080", AuthToken: "s3cr3t"}},
+		{name: "sse with mTLS", cfg: Config{Transport: TransportSSE, Addr: ":8443", TLSCertFile: "cert.pem", TLSKeyFile: "key.pem", ClientCAFile: "ca.pem"}},
+		{name: "insecure without auth", cfg: Config{Transport: TransportHTTP, Addr: "0.0.0.0:8080", InsecureNoAuth: true}},
+		{name: "all interfaces without auth", cfg: Config{Transport: TransportHTTP, Addr: ":8080"}, wantErr: "listening on :8080 beyond localhost requires an auth token or a client CA (or --insecure-no-auth)"},
+		{name: "public address without auth", cfg: Config{Transport: TransportSSE, Addr: "10.0.0.5:8080", TLSCertFile: "cert.pem", TLSKeyFile: "key.pem"}, wantErr: "listening on 10.0.0.5:8080 beyond localhost requires an auth token or a client CA (or --insecure-no-auth)"},
+		{name: "host name without auth", cfg: Config{Transport: TransportHTTP, Addr: "mcp.internal:8080"}, wantErr: "listening on mcp.internal:8080 beyond localhost requires an auth token or a client CA (or --insecure-no-auth)"},
+		{name: "unknown transport", cfg: Config{Transport: "websocket", Addr: ":8080"}, wantErr: `transport "websocket" must be http or sse`},
+		{name: "missing address", cfg: Config{Transport: TransportHTTP}, wantErr: "listen address is required"},
+		{name: "certificate without key", cfg: Config{Transport: TransportHTTP, Addr: ":8443", TLSCertFile: "cert.pem"}, wantErr: "TLS certificate and key must be given together"},
+		{name: "client CA without TLS", cfg: Config{Transport: TransportHTTP, Addr: ":8080", ClientCAFile: "ca.pem"}, wantErr: "client CA requires a TLS certificate and key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfig_TLSConfig(t *testing.T) {
+	cfg, err := Config{}.tlsConfig()
+	require.NoError(t, err)
+	assert.Nil(t, cfg, "no certificate serves plain HTTP")
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0o600))
+	_, err = Config{TLSCertFile: "cert.pem", TLSKeyFile: "key.pem", ClientCAFile: caFile}.tlsConfig()
+	assert.ErrorContains(t, err, "holds no PEM certificates")
+}
+
+func TestHandler(t *testing.T) {
+	for _, tt := range []struct {
+		transport string
+		path      string
+	}{
+		{TransportHTTP, MCPPath},
+		{TransportSSE, SSEPath},
+	} {
+		t.Run(tt.transport, func(t *testing.T) {
+			ts := httptest.NewServer(Handler(testServer(), Config{Transport: tt.transport, AuthToken: "s3cr3t"}, testLogger()))
+			t.Cleanup(ts.Close)
+
+			resp, err := http.Get(ts.URL + HealthPath)
+			require.NoError(t, err)
+			body, _ := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			assert.Equal(t, http.StatusOK, resp.StatusCode, "health checks need no token")
+			assert.Equal(t, "ok\n", string(body))
+
+			_, err = connect(ts.URL+tt.path, tt.transport, "")
+			assert.Error(t, err, "clients without the token are rejected")
+			_, err = connect(ts.URL+tt.path, tt.transport, "wrong")
+			assert.Error(t, err, "clients with a wrong token are rejected")
+
+			cs, err := connect(ts.URL+tt.path, tt.transport, "s3cr3t")
+			require.NoError(t, err)
+			defer cs.Close()
+
+			result, err := cs.CallTool(context.Background(), &mcp.CallToolParams{Name: "ping"})
+			require.NoError(t, err)
+			assert.Equal(t, "pong", result.Content[0].(*mcp.TextContent).Text)
+		})
+	}
+}
+
+func TestHandler_WithoutAuth(t *testing.T) {
+	ts := httptest.NewServer(Handler(testServer(), Config{Transport: TransportHTTP}, testLogger()))
+	t.Cleanup(ts.Close)
+
+	cs, err := connect(ts.URL+MCPPath, TransportHTTP, "")
+	require.NoError(t, err)
+	defer cs.Close()
+}
+
+func TestHandler_IsolatesSessions(t *testing.T) {
+	server := testServer()
+	session.RegisterTools(server)
+	ts := httptest.NewServer(Handler(server, Config{Transport: TransportHTTP}, testLogger()))
+	t.Cleanup(ts.Close)
+
+	ctx := context.Background()
+	alice, err := connect(ts.URL+MCPPath, TransportHTTP, "")
+	require.NoError(t, err)
+	defer alice.Close()
+	bob, err := connect(ts.URL+MCPPath, TransportHTTP, "")
+	require.NoError(t, err)
+	defer bob.Close()
+
+	_, err = alice.CallTool(ctx, &mcp.CallToolParams{Name: "gh_context_set", Arguments: map[string]any{"repo": "alice/repo"}})
+	require.NoError(t, err)
+
+	result, err := alice.CallTool(ctx, &mcp.CallToolParams{Name: "gh_context_get"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"repo": "alice/repo"}, result.StructuredContent)
+
+	result, err = bob.CallTool(ctx, &mcp.CallToolParams{Name: "gh_context_get"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{}, result.StructuredContent, "session defaults are not shared")
+}
+
+func TestHandler_SessionCredentials(t *testing.T) {
+	tests := []struct {
+		name      string
+		authToken string
+		header    http.Header
+		want      string
+	}{
+		{
+			name:   "github token header",
+			header: http.Header{session.TokenHeader: {"gho_alice"}},
+			want:   "gho_alice",
+		},
+		{
+			name:   "authorization header without server auth",
+			header: http.Header{"Authorization": {"Bearer gho_alice"}},
+			want:   "gho_alice",
+		},
+		{
+			name:      "github token header with server auth",
+			authToken: "s3cr3t",
+			header:    http.Header{"Authorization": {"Bearer s3cr3t"}, session.TokenHeader: {"gho_alice"}},
+			want:      "gho_alice",
+		},
+		{
+			name:      "server auth token is not a github token",
+			authToken: "s3cr3t",
+			header:    http.Header{"Authorization": {"Bearer s3cr3t"}},
+			want:      "host login",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(Handler(testServer(), Config{Transport: TransportHTTP, AuthToken: tt.authToken}, testLogger()))
+			t.Cleanup(ts.Close)
+
+			cs, err := connectWithHeader(ts.URL+MCPPath, TransportHTTP, tt.header)
+			require.NoError(t, err)
+			defer cs.Close()
+
+			result, err := cs.CallTool(context.Background(), &mcp.CallToolParams{Name: "whoami"})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result.Content[0].(*mcp.TextContent).Text)
+		})
+	}
+}
+
+// testServer returns a server with a ping tool, and a whoami tool reporting
+// the session's GitHub token.
+func testServer() *mcp.Server {
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "ping"}, func(context.Context, *mcp.CallToolRequest, struct{}) (*mcp.CallToolResult, any, error) {
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "pong"}}}, nil, nil
+	})
+	mcp.AddTool(server, &mcp.Tool{Name: "whoami"}, func(_ context.Context, req *mcp.CallToolRequest, _ struct{}) (*mcp.CallToolResult, any, error) {
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		text := "host login"
+		if creds != nil {
+			text = creds.Token
+		}
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: text}}}, nil, nil
+	})
+	return server
+}
+
+func testLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+}
+
+// connect opens a client session to endpoint, sending token as a bearer
+// token when set.
+func connect(endpoint, transport, token string) (*mcp.ClientSession, error) {
+	header := http.Header{}
+	if token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+	return connectWithHeader(endpoint, transport, header)
+}
+
+// connectWithHeader opens a client session to endpoint, sending header with
+// every request.
+func connectWithHeader(endpoint, transport string, header http.Header) (*mcp.ClientSession, error) {
+	httpClient := &http.Client{Transport: headerTransport{header: header}}
+
+	var clientTransport mcp.Transport = &mcp.StreamableClientTransport{Endpoint: endpoint, HTTPClient: httpClient, MaxRetries: -1}
+	if transport == TransportSSE {
+		clientTransport = &mcp.SSEClientTransport{Endpoint: endpoint, HTTPClient: httpClient}
+	}
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	return client.Connect(context.Background(), clientTransport, nil)
+}
+
+// headerTransport adds its header to every request.
+type headerTransport struct {
+	header http.Header
+}
+
+func (h headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for name, values := range h.header {
+		req.Header[name] = values
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
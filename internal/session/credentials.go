@@ -0,0 +1,105 @@
+package session
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+)
+
+// TokenHeader is the HTTP header carrying a session's GitHub token. Clients
+// may use Authorization instead when the server has no auth token of its own.
+const TokenHeader = "X-GitHub-Token"
+
+// TokenMetaKey is the initialize _meta key carrying a session's GitHub
+// token, for transports without HTTP headers.
+const TokenMetaKey = "github_token"
+
+// sessionCredentials are the credentials of one live session.
+type sessionCredentials struct {
+	token     string
+	homeDir   string
+	configDir string
+}
+
+// credentialStore holds the credentials of every live session.
+var credentialStore = struct {
+	sync.Mutex
+	sessions map[*mcp.ServerSession]*sessionCredentials
+}{sessions: make(map[*mcp.ServerSession]*sessionCredentials)}
+
+// Credentials returns the GitHub credentials supplied by the session that
+// sent req, or nil when it supplied none. Each session gets a home directory
+// of its own, holding its gh config directory, removed when the session
+// ends. A session may rotate its token; the latest one is used.
+func Credentials(req *mcp.CallToolRequest) (*executor.Credentials, error) {
+	if req == nil || req.Session == nil {
+		return nil, nil
+	}
+	token := requestToken(req)
+
+	credentialStore.Lock()
+	defer credentialStore.Unlock()
+
+	creds, ok := credentialStore.sessions[req.Session]
+	if !ok {
+		if token == "" {
+			return nil, nil
+		}
+		home, err := os.MkdirTemp("", "mcp-go-gh-session-")
+		if err != nil {
+			return nil, fmt.Errorf("creating session home directory: %w", err)
+		}
+		configDir := filepath.Join(home, ".config", "gh")
+		if err := os.MkdirAll(configDir, 0o700); err != nil {
+			_ = os.RemoveAll(home)
+			return nil, fmt.Errorf("creating session gh config directory: %w", err)
+		}
+		creds = &sessionCredentials{homeDir: home, configDir: configDir}
+		credentialStore.sessions[req.Session] = creds
+		go forgetCredentials(req.Session)
+	}
+	if token != "" {
+		creds.token = token
+	}
+	return &executor.Credentials{Token: creds.token, ConfigDir: creds.configDir, HomeDir: creds.homeDir}, nil
+}
+
+// forgetCredentials drops the credentials of ss once it ends, and removes
+// its home directory.
+func forgetCredentials(ss *mcp.ServerSession) {
+	_ = ss.Wait()
+	credentialStore.Lock()
+	creds := credentialStore.sessions[ss]
+	delete(credentialStore.sessions, ss)
+	credentialStore.Unlock()
+	_ = os.RemoveAll(creds.homeDir)
+}
+
+// requestToken returns the GitHub token sent with req: the TokenHeader, or
+// the Authorization bearer token unless the server used it to authenticate
+// the client, or the TokenMetaKey of the session's initialize request.
+func requestToken(req *mcp.CallToolRequest) string {
+	if extra := req.Extra; extra != nil && extra.Header != nil {
+		if token := strings.TrimSpace(extra.Header.Get(TokenHeader)); token != "" {
+			return token
+		}
+		if extra.TokenInfo == nil {
+			scheme, token, ok := strings.Cut(extra.Header.Get("Authorization"), " ")
+			if ok && strings.EqualFold(scheme, "Bearer") && strings.TrimSpace(token) != "" {
+				return strings.TrimSpace(token)
+			}
+		}
+	}
+	if params := req.Session.InitializeParams(); params != nil {
+		if token, ok := params.Meta[TokenMetaKey].(string); ok {
+			return strings.TrimSpace(token)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,89 @@
+package session
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+)
+
+func TestCredentials(t *testing.T) {
+	ctx := context.Background()
+	var creds *executor.Credentials
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "whoami"}, func(ctx context.Context, req *mcp.CallToolRequest, _ struct{}) (*mcp.CallToolResult, any, error) {
+		var err error
+		creds, err = Credentials(req)
+		require.NoError(t, err)
+		return &mcp.CallToolResult{}, nil, nil
+	})
+
+	// credentialsOf calls the tool in session and returns its credentials.
+	credentialsOf := func(session *mcp.ClientSession) *executor.Credentials {
+		_, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "whoami"})
+		require.NoError(t, err)
+		return creds
+	}
+
+	t.Run("sessions without a token use the host login", func(t *testing.T) {
+		assert.Nil(t, credentialsOf(connect(t, server)))
+	})
+
+	t.Run("sessions get their own token and config directory", func(t *testing.T) {
+		alice := connectWithToken(t, server, "alice-token")
+		bob := connectWithToken(t, server, "bob-token")
+
+		aliceCreds := credentialsOf(alice)
+		require.NotNil(t, aliceCreds)
+		assert.Equal(t, "alice-token", aliceCreds.Token)
+		assert.DirExists(t, aliceCreds.ConfigDir)
+		assert.DirExists(t, aliceCreds.HomeDir)
+		assert.True(t, strings.HasPrefix(aliceCreds.ConfigDir, aliceCreds.HomeDir), "the gh config directory is in the session's home")
+
+		bobCreds := credentialsOf(bob)
+		require.NotNil(t, bobCreds)
+		assert.Equal(t, "bob-token", bobCreds.Token)
+		assert.NotEqual(t, aliceCreds.HomeDir, bobCreds.HomeDir)
+
+		assert.Equal(t, aliceCreds, credentialsOf(alice), "credentials are stable within a session")
+
+		require.NoError(t, alice.Close())
+		assert.Eventually(t, func() bool {
+			_, err := os.Stat(aliceCreds.HomeDir)
+			return os.IsNotExist(err)
+		}, time.Second, 10*time.Millisecond, "home directory is removed when the session ends")
+	})
+}
+
+// connectWithToken returns a client session whose initialize request
+// carries token under TokenMetaKey.
+func connectWithToken(t *testing.T, server *mcp.Server, token string) *mcp.ClientSession {
+	t.Helper()
+	ctx := context.Background()
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	client.AddSendingMiddleware(func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if params, ok := req.GetParams().(*mcp.InitializeParams); ok {
+				params.SetMeta(map[string]any{TokenMetaKey: token})
+			}
+			return next(ctx, method, req)
+		}
+	})
+	session, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+	return session
+}
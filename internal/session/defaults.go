@@ -0,0 +1,108 @@
+// Package session keeps per-session defaults for the repository, host and
+// working directory of gh commands, and the tools that manage them.
+package session
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Defaults are applied to tool calls that do not choose a repository, host
+// or working directory themselves.
+type Defaults struct {
+	Repo     string `json:"repo,omitempty" jsonschema:"Default repository in [HOST/]OWNER/REPO format, passed to gh as GH_REPO"`
+	Hostname string `json:"hostname,omitempty" jsonschema:"Default GitHub hostname, passed to gh as GH_HOST"`
+	Cwd      string `json:"cwd,omitempty" jsonschema:"Default directory to run gh in"`
+}
+
+// IsZero reports whether no default is set.
+func (d Defaults) IsZero() bool {
+	return d == Defaults{}
+}
+
+// Without returns the defaults that still apply to a call that set the
+// non-empty fields of explicit itself.
+func (d Defaults) Without(explicit Defaults) Defaults {
+	if explicit.Repo != "" {
+		d.Repo = ""
+	}
+	if explicit.Hostname != "" {
+		d.Hostname = ""
+	}
+	if explicit.Cwd != "" {
+		d.Cwd = ""
+	}
+	return d
+}
+
+// Env returns the environment variables that apply the repository and host
+// defaults to a gh command.
+func (d Defaults) Env() []string {
+	var env []string
+	if d.Repo != "" {
+		env = append(env, "GH_REPO="+d.Repo)
+	}
+	if d.Hostname != "" {
+		env = append(env, "GH_HOST="+d.Hostname)
+	}
+	return env
+}
+
+// String describes the defaults for display in tool results.
+func (d Defaults) String() string {
+	var parts []string
+	if d.Repo != "" {
+		parts = append(parts, "repo "+d.Repo)
+	}
+	if d.Hostname != "" {
+		parts = append(parts, "host "+d.Hostname)
+	}
+	if d.Cwd != "" {
+		parts = append(parts, "cwd "+d.Cwd)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Annotate appends the defaults applied to a call to its result, so the
+// client can tell which repository was targeted. Results are returned
+// unchanged when no default was applied.
+func (d Defaults) Annotate(result *mcp.CallToolResult) *mcp.CallToolResult {
+	if d.IsZero() || result == nil {
+		return result
+	}
+	result.Content = append(result.Content, &mcp.TextContent{Text: "Session defaults: " + d.String()})
+	return result
+}
+
+// store holds the defaults of every live session.
+var store = struct {
+	sync.Mutex
+	defaults map[*mcp.ServerSession]Defaults
+}{defaults: make(map[*mcp.ServerSession]Defaults)}
+
+// For returns the defaults of the session that sent req.
+func For(req *mcp.CallToolRequest) Defaults {
+	if req == nil || req.Session == nil {
+		return Defaults{}
+	}
+	store.Lock()
+	defer store.Unlock()
+	return store.defaults[req.Session]
+}
+
+// set replaces the defaults of ss. They are dropped when the session ends.
+func set(ss *mcp.ServerSession, defaults Defaults) {
+	store.Lock()
+	defer store.Unlock()
+	if _, ok := store.defaults[ss]; !ok {
+		go func() {
+			_ = ss.Wait()
+			store.Lock()
+			defer store.Unlock()
+			delete(store.defaults, ss)
+		}()
+	}
+	store.defaults[ss] = defaults
+}
@@ -0,0 +1,39 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaults(t *testing.T) {
+	defaults := Defaults{Repo: "octo/hello", Hostname: "github.example.com", Cwd: "/src/hello"}
+
+	assert.Equal(t, []string{"GH_REPO=octo/hello", "GH_HOST=github.example.com"}, defaults.Env())
+	assert.Nil(t, Defaults{Cwd: "/src/hello"}.Env())
+	assert.Equal(t, "repo octo/hello, host github.example.com, cwd /src/hello", defaults.String())
+
+	assert.Equal(t, Defaults{Hostname: "github.example.com"}, defaults.Without(Defaults{Repo: "octo/other", Cwd: "/src/other"}))
+	assert.Equal(t, defaults, defaults.Without(Defaults{}))
+	assert.True(t, defaults.Without(defaults).IsZero())
+}
+
+func TestDefaults_Annotate(t *testing.T) {
+	t.Run("appends the applied defaults", func(t *testing.T) {
+		result := Defaults{Repo: "octo/hello"}.Annotate(&mcp.CallToolResult{
+			Content: []mcp.Content{&mcp.TextContent{Text: "output"}},
+		})
+
+		assert.Len(t, result.Content, 2)
+		assert.Equal(t, "Session defaults: repo octo/hello", result.Content[1].(*mcp.TextContent).Text)
+	})
+
+	t.Run("leaves results alone without defaults", func(t *testing.T) {
+		result := Defaults{}.Annotate(&mcp.CallToolResult{
+			Content: []mcp.Content{&mcp.TextContent{Text: "output"}},
+		})
+
+		assert.Len(t, result.Content, 1)
+	})
+}
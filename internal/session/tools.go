@@ -0,0 +1,114 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolset"
+)
+
+// SetArgs defines parameters for gh_context_set. Omitted arguments keep
+// their current default; an empty string clears it.
+type SetArgs struct {
+	Repo     *string `json:"repo,omitempty" jsonschema:"Default repository in [HOST/]OWNER/REPO format (empty to clear)"`
+	Hostname *string `json:"hostname,omitempty" jsonschema:"Default GitHub hostname (empty to clear)"`
+	Cwd      *string `json:"cwd,omitempty" jsonschema:"Absolute path of the default directory to run gh in (empty to clear)"`
+}
+
+// repoPattern matches the [HOST/]OWNER/REPO syntax accepted by GH_REPO.
+var repoPattern = regexp.MustCompile(`^([^/\s]+/)?[^/\s]+/[^/\s]+$`)
+
+// outputSchema describes the defaults returned by both tools. Handlers
+// return them as an untyped value so that error results keep their own
+// structured content.
+var outputSchema = mustSchema[Defaults]()
+
+func mustSchema[T any]() *jsonschema.Schema {
+	schema, err := jsonschema.For[T](nil)
+	if err != nil {
+		panic(fmt.Sprintf("session: inferring schema: %v", err))
+	}
+	return schema
+}
+
+// Toolset holds the gh_context tools. They manage session defaults and
+// never run gh, so they are read tools as far as GitHub is concerned.
+var Toolset = toolset.Toolset{
+	Name: "context",
+	Tools: []toolset.Tool{
+		{Name: "gh_context_set", Access: toolset.AccessRead, Register: registerSetTool},
+		{Name: "gh_context_get", Access: toolset.AccessRead, Register: registerGetTool},
+	},
+}
+
+// RegisterTools registers the gh_context_set and gh_context_get tools.
+func RegisterTools(server *mcp.Server) {
+	for _, tool := range Toolset.Tools {
+		tool.Register(server, nil)
+	}
+}
+
+// registerSetTool registers the gh_context_set tool.
+func registerSetTool(server *mcp.Server, _ executor.Runner) {
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         "gh_context_set",
+		Description:  "Set the default repository, hostname and working directory for later tool calls in this session",
+		OutputSchema: outputSchema,
+		Annotations: &mcp.ToolAnnotations{
+			Title:           "Set Session Defaults",
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(false),
+		},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args SetArgs) (*mcp.CallToolResult, any, error) {
+		defaults := For(req)
+		if args.Repo != nil {
+			if *args.Repo != "" && !repoPattern.MatchString(*args.Repo) {
+				return toolkit.ValidationErrorResult("gh_context_set", fmt.Errorf("repo %q must be in [HOST/]OWNER/REPO format", *args.Repo)), nil, nil
+			}
+			defaults.Repo = *args.Repo
+		}
+		if args.Hostname != nil {
+			if strings.ContainsAny(*args.Hostname, "/ \t\n") {
+				return toolkit.ValidationErrorResult("gh_context_set", fmt.Errorf("hostname %q is not a valid host name", *args.Hostname)), nil, nil
+			}
+			defaults.Hostname = *args.Hostname
+		}
+		if args.Cwd != nil {
+			dir, err := toolkit.WorkingDir(ctx, req, *args.Cwd)
+			if err != nil {
+				return toolkit.ValidationErrorResult("gh_context_set", err), nil, nil
+			}
+			defaults.Cwd = dir
+		}
+
+		if req == nil || req.Session == nil {
+			return nil, nil, fmt.Errorf("gh_context_set requires a session")
+		}
+		set(req.Session, defaults)
+		return nil, defaults, nil
+	})
+}
+
+// registerGetTool registers the gh_context_get tool.
+func registerGetTool(server *mcp.Server, _ executor.Runner) {
+	mcp.AddTool(server, &mcp.Tool{
+		Name:         "gh_context_get",
+		Description:  "Show the default repository, hostname and working directory of this session",
+		OutputSchema: outputSchema,
+		Annotations: &mcp.ToolAnnotations{
+			Title:         "Get Session Defaults",
+			ReadOnlyHint:  true,
+			OpenWorldHint: toolkit.Bool(false),
+		},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, _ struct{}) (*mcp.CallToolResult, any, error) {
+		return nil, For(req), nil
+	})
+}
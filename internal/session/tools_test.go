@@ -0,0 +1,88 @@
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// connect returns a client session of a new client connected to server.
+func connect(t *testing.T, server *mcp.Server) *mcp.ClientSession {
+	t.Helper()
+	ctx := context.Background()
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, &mcp.ClientOptions{
+		Capabilities: &mcp.ClientCapabilities{},
+	})
+	session, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+	return session
+}
+
+func TestRegisterTools(t *testing.T) {
+	ctx := context.Background()
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	RegisterTools(server)
+
+	// repoOf calls gh_context_get and returns the repo default.
+	repoOf := func(session *mcp.ClientSession) any {
+		result, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "gh_context_get"})
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		return result.StructuredContent.(map[string]any)["repo"]
+	}
+
+	first, second := connect(t, server), connect(t, server)
+	cwd := t.TempDir()
+
+	t.Run("sets defaults for the calling session only", func(t *testing.T) {
+		result, err := first.CallTool(ctx, &mcp.CallToolParams{
+			Name:      "gh_context_set",
+			Arguments: map[string]any{"repo": "octo/hello", "hostname": "github.example.com", "cwd": cwd},
+		})
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.Equal(t, "octo/hello", result.StructuredContent.(map[string]any)["repo"])
+
+		assert.Equal(t, "octo/hello", repoOf(first))
+		assert.Nil(t, repoOf(second))
+	})
+
+	t.Run("omitted arguments keep their default", func(t *testing.T) {
+		_, err := first.CallTool(ctx, &mcp.CallToolParams{
+			Name:      "gh_context_set",
+			Arguments: map[string]any{"hostname": ""},
+		})
+		require.NoError(t, err)
+
+		result, err := first.CallTool(ctx, &mcp.CallToolParams{Name: "gh_context_get"})
+		require.NoError(t, err)
+		content := result.StructuredContent.(map[string]any)
+		assert.Equal(t, "octo/hello", content["repo"])
+		assert.Nil(t, content["hostname"], "empty string clears the default")
+		assert.NotEmpty(t, content["cwd"])
+	})
+
+	t.Run("rejects invalid defaults", func(t *testing.T) {
+		for _, args := range []map[string]any{
+			{"repo": "hello"},
+			{"hostname": "github.com/octo"},
+			{"cwd": "relative/dir"},
+		} {
+			result, err := first.CallTool(ctx, &mcp.CallToolParams{Name: "gh_context_set", Arguments: args})
+			require.NoError(t, err)
+			assert.True(t, result.IsError, args)
+			assert.Equal(t, "validation", result.StructuredContent.(map[string]any)["kind"], args)
+		}
+		assert.Equal(t, "octo/hello", repoOf(first), "failed calls leave the defaults unchanged")
+	})
+}
@@ -0,0 +1,6 @@
+package toolkit
+
+// Bool returns a pointer to b, for the optional hints in mcp.ToolAnnotations.
+func Bool(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,160 @@
+package toolkit
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+)
+
+// confirmTTL is how long a confirm token stays valid.
+const confirmTTL = 5 * time.Minute
+
+// pendingConfirmation is a destructive command awaiting the caller's approval.
+type pendingConfirmation struct {
+	session *mcp.ServerSession
+	command string
+	expires time.Time
+}
+
+var (
+	confirmMu sync.Mutex
+	pending   = make(map[string]pendingConfirmation)
+)
+
+// Confirm asks the human to approve a destructive gh command before it runs.
+// It returns nil once the command is approved, or the result to return
+// instead of running it.
+//
+// The user is shown the exact command line, prefixed with the directory gh
+// runs in and the environment that picks its repository and host (e.g.
+// cd /work && GH_REPO=octo/hello gh repo delete --yes), since session
+// defaults change what an otherwise identical command targets. Input piped
+// to gh on stdin is shown by its length and SHA-256, since it may be a
+// secret, so that it cannot be swapped once approved. Clients that
+// support elicitation show it in a prompt. Other clients get a single-use
+// confirm token, bound to the session and that line, that the tool must be
+// called again with once the user agrees. command is the gh command path,
+// e.g. "gh repo delete".
+func Confirm(ctx context.Context, req *mcp.CallToolRequest, command, token, dir string, env, argv []string, stdin string) *mcp.CallToolResult {
+	line := invocationLine(dir, env, argv, stdin)
+	ss := req.Session
+
+	if token != "" {
+		if !redeem(ss, token, line) {
+			return ValidationErrorResult(command, fmt.Errorf("confirm token is invalid, expired or was issued for a different command"))
+		}
+		return nil
+	}
+
+	if supportsElicitation(ss) {
+		res, err := ss.Elicit(ctx, &mcp.ElicitParams{
+			Message:         fmt.Sprintf("Allow this destructive command to run?\n\n%s", line),
+			RequestedSchema: map[string]any{"type": "object", "properties": map[string]any{}},
+		})
+		if err != nil {
+			return ErrorResult(command, nil, fmt.Errorf("asking for confirmation: %w", err))
+		}
+		if res.Action != "accept" {
+			return ToolError{
+				Kind:    executor.KindDeclined,
+				Command: command,
+				Message: fmt.Sprintf("the user did not approve %s (%s)", line, res.Action),
+			}.Result()
+		}
+		return nil
+	}
+
+	return ToolError{
+		Kind:    executor.KindConfirmationRequired,
+		Command: command,
+		Message: fmt.Sprintf("destructive command not run: show the user %s and, only if they approve, call the tool again with the same arguments and confirm_token %q (valid for %s)",
+			line, issue(ss, line), confirmTTL),
+	}.Result()
+}
+
+// supportsElicitation reports whether the client can show a form to the user.
+func supportsElicitation(ss *mcp.ServerSession) bool {
+	if ss == nil {
+		return false
+	}
+	params := ss.InitializeParams()
+	if params == nil || params.Capabilities == nil || params.Capabilities.Elicitation == nil {
+		return false
+	}
+	caps := params.Capabilities.Elicitation
+	return caps.Form != nil || caps.URL == nil
+}
+
+// issue records a pending confirmation and returns its token.
+func issue(ss *mcp.ServerSession, command string) string {
+	confirmMu.Lock()
+	defer confirmMu.Unlock()
+
+	now := time.Now()
+	for token, p := range pending {
+		if now.After(p.expires) {
+			delete(pending, token)
+		}
+	}
+	token := rand.Text()
+	pending[token] = pendingConfirmation{session: ss, command: command, expires: now.Add(confirmTTL)}
+	return token
+}
+
+// redeem consumes a confirm token, reporting whether it was issued to the
+// session for the command and has not expired.
+func redeem(ss *mcp.ServerSession, token, command string) bool {
+	confirmMu.Lock()
+	defer confirmMu.Unlock()
+
+	p, ok := pending[token]
+	if !ok {
+		return false
+	}
+	delete(pending, token)
+	return p.session == ss && p.command == command && time.Now().Before(p.expires)
+}
+
+// invocationLine formats argv as a shell command run in dir with env, and
+// notes the size and digest of its stdin.
+func invocationLine(dir string, env, argv []string, stdin string) string {
+	var parts []string
+	if dir != "" {
+		parts = append(parts, "cd", quoteArg(dir), "&&")
+	}
+	for _, kv := range env {
+		parts = append(parts, quoteArg(kv))
+	}
+	parts = append(parts, CommandLine(argv))
+	if stdin != "" {
+		parts = append(parts, fmt.Sprintf("<<< [%d bytes, sha256 %x]", len(stdin), sha256.Sum256([]byte(stdin))))
+	}
+	return strings.Join(parts, " ")
+}
+
+// CommandLine formats argv as a gh command line, quoting arguments that
+// contain spaces or quotes.
+func CommandLine(argv []string) string {
+	parts := []string{"gh"}
+	for _, arg := range argv {
+		parts = append(parts, quoteArg(arg))
+	}
+	return strings.Join(parts, " ")
+}
+
+// quoteArg quotes arg if it is empty or contains spaces or quotes.
+func quoteArg(arg string) string {
+	if arg == "" || strings.ContainsAny(arg, " \t\n\"'\\") {
+		return strconv.Quote(arg)
+	}
+	return arg
+}
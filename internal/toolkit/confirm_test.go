@@ -0,0 +1,144 @@
+package toolkit
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+)
+
+func TestConfirm(t *testing.T) {
+	argv := []string{"repo", "delete", "owner/repo", "--yes"}
+
+	t.Run("client approves through elicitation", func(t *testing.T) {
+		var message string
+		client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, &mcp.ClientOptions{
+			ElicitationHandler: func(ctx context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+				message = req.Params.Message
+				return &mcp.ElicitResult{Action: "accept"}, nil
+			},
+		})
+
+		result := callConfirm(t, client)("", nil, argv, "")
+		assert.Nil(t, result, "approved commands run")
+		assert.Contains(t, message, "gh repo delete owner/repo --yes")
+	})
+
+	t.Run("client declines through elicitation", func(t *testing.T) {
+		client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, &mcp.ClientOptions{
+			ElicitationHandler: func(ctx context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+				return &mcp.ElicitResult{Action: "decline"}, nil
+			},
+		})
+
+		result := callConfirm(t, client)("", nil, argv, "")
+		require.NotNil(t, result)
+		assert.True(t, result.IsError)
+		assert.Equal(t, executor.KindDeclined, result.StructuredContent.(ToolError).Kind)
+	})
+
+	t.Run("client without elicitation confirms with a token", func(t *testing.T) {
+		confirm := callConfirm(t, mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil))
+
+		result := confirm("", nil, argv, "")
+		require.NotNil(t, result)
+		toolErr := result.StructuredContent.(ToolError)
+		assert.Equal(t, executor.KindConfirmationRequired, toolErr.Kind)
+		assert.Equal(t, "gh repo delete", toolErr.Command)
+		assert.Contains(t, toolErr.Message, "gh repo delete owner/repo --yes")
+		token := regexp.MustCompile(`confirm_token "([^"]+)"`).FindStringSubmatch(toolErr.Message)
+		require.Len(t, token, 2)
+
+		other := confirm("", nil, argv, "").StructuredContent.(ToolError).Message
+		otherToken := regexp.MustCompile(`confirm_token "([^"]+)"`).FindStringSubmatch(other)[1]
+		result = confirm(otherToken, nil, []string{"repo", "delete", "owner/other", "--yes"}, "")
+		require.NotNil(t, result, "tokens are bound to the command line")
+		assert.Equal(t, executor.KindValidation, result.StructuredContent.(ToolError).Kind)
+
+		assert.Nil(t, confirm(token[1], nil, argv, ""), "a valid token runs the command")
+		assert.NotNil(t, confirm(token[1], nil, argv, ""), "tokens are single-use")
+	})
+
+	t.Run("the directory and environment are shown and bound to the token", func(t *testing.T) {
+		confirm := callConfirm(t, mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil))
+		env := []string{"GH_REPO=octo/hello", "GH_HOST=github.example.com"}
+
+		message := confirm("", env, argv, "").StructuredContent.(ToolError).Message
+		assert.Contains(t, message, `cd "/work tree" && GH_REPO=octo/hello GH_HOST=github.example.com gh repo delete owner/repo --yes`)
+		token := regexp.MustCompile(`confirm_token "([^"]+)"`).FindStringSubmatch(message)[1]
+		assert.NotNil(t, confirm(token, []string{"GH_REPO=octo/other", "GH_HOST=github.example.com"}, argv, ""), "tokens are bound to the repository")
+
+		message = confirm("", env, argv, "").StructuredContent.(ToolError).Message
+		token = regexp.MustCompile(`confirm_token "([^"]+)"`).FindStringSubmatch(message)[1]
+		assert.Nil(t, confirm(token, env, argv, ""))
+	})
+
+	t.Run("stdin is shown by digest and bound to the token", func(t *testing.T) {
+		confirm := callConfirm(t, mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil))
+		api := []string{"api", "repos/octo/hello/issues", "--method", "POST", "--input", "-"}
+		body := `{"title":"t"}`
+
+		message := confirm("", nil, api, body).StructuredContent.(ToolError).Message
+		assert.Contains(t, message, "gh api repos/octo/hello/issues --method POST --input - <<< [13 bytes, sha256 ")
+		assert.NotContains(t, message, body, "stdin may be a secret")
+		token := regexp.MustCompile(`confirm_token "([^"]+)"`).FindStringSubmatch(message)[1]
+		assert.NotNil(t, confirm(token, nil, api, `{"title":"other"}`), "tokens are bound to the input")
+
+		message = confirm("", nil, api, body).StructuredContent.(ToolError).Message
+		token = regexp.MustCompile(`confirm_token "([^"]+)"`).FindStringSubmatch(message)[1]
+		assert.Nil(t, confirm(token, nil, api, body))
+	})
+
+	t.Run("tokens are bound to the session", func(t *testing.T) {
+		first := callConfirm(t, mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil))
+		second := callConfirm(t, mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil))
+
+		message := first("", nil, argv, "").StructuredContent.(ToolError).Message
+		token := regexp.MustCompile(`confirm_token "([^"]+)"`).FindStringSubmatch(message)[1]
+		assert.NotNil(t, second(token, nil, argv, ""))
+	})
+}
+
+func TestCommandLine(t *testing.T) {
+	assert.Equal(t, "gh repo delete owner/repo --yes", CommandLine([]string{"repo", "delete", "owner/repo", "--yes"}))
+	assert.Equal(t, `gh release delete "v1 beta" --notes ""`, CommandLine([]string{"release", "delete", "v1 beta", "--notes", ""}))
+}
+
+// callConfirm connects client to a server whose tool runs Confirm, and
+// returns a function calling that tool and returning Confirm's result.
+func callConfirm(t *testing.T, client *mcp.Client) func(token string, env, argv []string, stdin string) *mcp.CallToolResult {
+	t.Helper()
+	ctx := context.Background()
+
+	type args struct {
+		Token string   `json:"token"`
+		Env   []string `json:"env,omitempty"`
+		Argv  []string `json:"argv"`
+		Stdin string   `json:"stdin,omitempty"`
+	}
+	var result *mcp.CallToolResult
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "delete"}, func(ctx context.Context, req *mcp.CallToolRequest, args args) (*mcp.CallToolResult, any, error) {
+		result = Confirm(ctx, req, "gh repo delete", args.Token, "/work tree", args.Env, args.Argv, args.Stdin)
+		return &mcp.CallToolResult{}, nil, nil
+	})
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+	session, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+
+	return func(token string, env, argv []string, stdin string) *mcp.CallToolResult {
+		_, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "delete", Arguments: args{Token: token, Env: env, Argv: argv, Stdin: stdin}})
+		require.NoError(t, err)
+		return result
+	}
+}
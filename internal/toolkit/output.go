@@ -0,0 +1,107 @@
+package toolkit
+
+import (
+	"encoding/json"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// OutputField describes one field of the JSON objects gh prints with --json.
+type OutputField struct {
+	Name        string
+	Type        string
+	Description string
+	Nullable    bool
+}
+
+// OutputSpec describes the JSON document gh prints for a command run with
+// --json. When Array is set gh prints a list of objects, which is exposed as
+// {"items": [...]} because MCP structured content must be an object.
+//
+// A tool with an output schema must return structured content from every
+// call, so output that is not JSON (format text, jq, template, or a message
+// such as "no pull requests match your search") is exposed as {"text": ...}.
+type OutputSpec struct {
+	Array  bool
+	Fields []OutputField
+}
+
+// textProperty is the output schema property holding output that is not
+// JSON.
+var textProperty = &jsonschema.Schema{
+	Type:        "string",
+	Description: "gh's output when it is not JSON: with format text, jq or template, or when gh prints a message instead of data",
+}
+
+// OutputSchema builds the tool output schema described by spec. Fields are
+// never required, as gh only prints the fields requested with --json, and
+// text output has a property of its own.
+func OutputSchema(spec OutputSpec) *jsonschema.Schema {
+	object := &jsonschema.Schema{
+		Type:       "object",
+		Properties: make(map[string]*jsonschema.Schema, len(spec.Fields)),
+	}
+	for _, field := range spec.Fields {
+		ps := &jsonschema.Schema{Description: field.Description}
+		if field.Nullable {
+			ps.Types = []string{"null", field.Type}
+		} else {
+			ps.Type = field.Type
+		}
+		object.Properties[field.Name] = ps
+	}
+
+	if !spec.Array {
+		object.Properties["text"] = textProperty
+		return object
+	}
+	return &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"items": {Type: "array", Items: object},
+			"text":  textProperty,
+		},
+	}
+}
+
+// JSONResult returns gh's --json output as structured content, wrapping
+// arrays as {"items": [...]}. Output that does not decode as JSON of the
+// expected shape is returned as a TextResult.
+func JSONResult(stdout string, array bool) *mcp.CallToolResult {
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: stdout},
+		},
+	}
+
+	if array {
+		var items []any
+		if err := json.Unmarshal([]byte(stdout), &items); err != nil {
+			return TextResult(stdout)
+		}
+		if items == nil {
+			items = []any{}
+		}
+		result.StructuredContent = map[string]any{"items": items}
+		return result
+	}
+
+	var object map[string]any
+	if err := json.Unmarshal([]byte(stdout), &object); err != nil || object == nil {
+		return TextResult(stdout)
+	}
+	result.StructuredContent = object
+	return result
+}
+
+// TextResult returns gh output that is not JSON from a tool with an output
+// schema, as text content and as {"text": ...} structured content.
+func TextResult(stdout string) *mcp.CallToolResult {
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: stdout},
+		},
+		StructuredContent: map[string]any{"text": stdout},
+	}
+}
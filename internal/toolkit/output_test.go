@@ -0,0 +1,100 @@
+package toolkit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testOutputFields = []OutputField{
+	{Name: "number", Type: "integer", Description: "Issue number"},
+	{Name: "milestone", Type: "object", Description: "Milestone", Nullable: true},
+}
+
+func TestOutputSchema(t *testing.T) {
+	t.Run("object output", func(t *testing.T) {
+		schema := OutputSchema(OutputSpec{Fields: testOutputFields})
+
+		assert.Equal(t, "object", schema.Type)
+		assert.Equal(t, "integer", schema.Properties["number"].Type)
+		assert.Equal(t, "Issue number", schema.Properties["number"].Description)
+		assert.Equal(t, []string{"null", "object"}, schema.Properties["milestone"].Types)
+		assert.Empty(t, schema.Required, "gh only prints requested fields")
+	})
+
+	t.Run("array output is wrapped in items", func(t *testing.T) {
+		schema := OutputSchema(OutputSpec{Array: true, Fields: testOutputFields})
+
+		assert.Equal(t, "object", schema.Type)
+		items := schema.Properties["items"]
+		require.NotNil(t, items)
+		assert.Equal(t, "array", items.Type)
+		require.NotNil(t, items.Items)
+		assert.Equal(t, "integer", items.Items.Properties["number"].Type)
+	})
+
+	t.Run("schema validates gh output", func(t *testing.T) {
+		resolved, err := OutputSchema(OutputSpec{Array: true, Fields: testOutputFields}).Resolve(nil)
+		require.NoError(t, err)
+
+		var output map[string]any
+		require.NoError(t, json.Unmarshal([]byte(`{"items": [{"number": 1, "milestone": null}]}`), &output))
+		assert.NoError(t, resolved.Validate(output))
+	})
+}
+
+func TestJSONResult(t *testing.T) {
+	t.Run("array output", func(t *testing.T) {
+		stdout := `[{"number":1},{"number":2}]`
+
+		result := JSONResult(stdout, true)
+
+		assert.False(t, result.IsError)
+		assert.Equal(t, stdout, resultText(t, result))
+		assert.Equal(t, map[string]any{
+			"items": []any{
+				map[string]any{"number": float64(1)},
+				map[string]any{"number": float64(2)},
+			},
+		}, result.StructuredContent)
+	})
+
+	t.Run("empty array output", func(t *testing.T) {
+		result := JSONResult("[]\n", true)
+
+		assert.Equal(t, map[string]any{"items": []any{}}, result.StructuredContent)
+	})
+
+	t.Run("object output", func(t *testing.T) {
+		result := JSONResult(`{"number":1,"title":"Bug"}`, false)
+
+		assert.Equal(t, map[string]any{"number": float64(1), "title": "Bug"}, result.StructuredContent)
+	})
+
+	t.Run("invalid JSON falls back to text", func(t *testing.T) {
+		result := JSONResult("no pull requests match your search\n", true)
+
+		assert.Equal(t, map[string]any{"text": "no pull requests match your search\n"}, result.StructuredContent)
+		assert.Equal(t, "no pull requests match your search\n", resultText(t, result))
+	})
+
+	t.Run("mismatched shape falls back to text", func(t *testing.T) {
+		result := JSONResult(`{"number":1}`, true)
+
+		assert.Equal(t, map[string]any{"text": `{"number":1}`}, result.StructuredContent)
+	})
+}
+
+func TestTextResult(t *testing.T) {
+	result := TextResult("#1  Bug\n")
+
+	assert.False(t, result.IsError)
+	assert.Equal(t, "#1  Bug\n", resultText(t, result))
+	for _, spec := range []OutputSpec{{Fields: testOutputFields}, {Array: true, Fields: testOutputFields}} {
+		resolved, err := OutputSchema(spec).Resolve(nil)
+		require.NoError(t, err)
+		assert.NoError(t, resolved.Validate(result.StructuredContent), "text output conforms to the output schema")
+	}
+}
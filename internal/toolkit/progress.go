@@ -0,0 +1,31 @@
+package toolkit
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Progress returns a callback that reports each output line of a running gh
+// command to the client as a notifications/progress message. It returns nil,
+// disabling streaming, when the request carries no progress token.
+func Progress(ctx context.Context, req *mcp.CallToolRequest) func(line string) {
+	if req == nil || req.Session == nil || req.Params == nil {
+		return nil
+	}
+	token := req.Params.GetProgressToken()
+	if token == nil {
+		return nil
+	}
+
+	var progress float64
+	return func(line string) {
+		progress++
+		// A lost notification must not fail the command, so errors are ignored.
+		_ = req.Session.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+			ProgressToken: token,
+			Progress:      progress,
+			Message:       line,
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package toolkit
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgress(t *testing.T) {
+	t.Run("nil without a request", func(t *testing.T) {
+		assert.Nil(t, Progress(context.Background(), nil))
+	})
+
+	t.Run("reports lines when the request has a progress token", func(t *testing.T) {
+		ctx := context.Background()
+
+		var (
+			mu            sync.Mutex
+			notifications []*mcp.ProgressNotificationParams
+			done          = make(chan struct{})
+		)
+		client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, &mcp.ClientOptions{
+			ProgressNotificationHandler: func(_ context.Context, req *mcp.ProgressNotificationClientRequest) {
+				mu.Lock()
+				defer mu.Unlock()
+				notifications = append(notifications, req.Params)
+				if len(notifications) == 2 {
+					close(done)
+				}
+			},
+		})
+
+		server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+		var streaming bool
+		mcp.AddTool(server, &mcp.Tool{Name: "watch"}, func(ctx context.Context, req *mcp.CallToolRequest, _ struct{}) (*mcp.CallToolResult, any, error) {
+			onLine := Progress(ctx, req)
+			streaming = onLine != nil
+			if onLine != nil {
+				onLine("queued")
+				onLine("completed")
+			}
+			return &mcp.CallToolResult{}, nil, nil
+		})
+
+		serverTransport, clientTransport := mcp.NewInMemoryTransports()
+		serverSession, err := server.Connect(ctx, serverTransport, nil)
+		require.NoError(t, err)
+		defer serverSession.Close()
+		session, err := client.Connect(ctx, clientTransport, nil)
+		require.NoError(t, err)
+		defer session.Close()
+
+		_, err = session.CallTool(ctx, &mcp.CallToolParams{Name: "watch"})
+		require.NoError(t, err)
+		assert.False(t, streaming, "no progress token, no streaming")
+
+		params := &mcp.CallToolParams{Name: "watch", Meta: mcp.Meta{"progressToken": "run-1"}}
+		_, err = session.CallTool(ctx, params)
+		require.NoError(t, err)
+		assert.True(t, streaming)
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for progress notifications")
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(t, notifications, 2)
+		assert.Equal(t, "run-1", notifications[0].ProgressToken)
+		assert.Equal(t, "queued", notifications[0].Message)
+		assert.Equal(t, 1.0, notifications[0].Progress)
+		assert.Equal(t, "completed", notifications[1].Message)
+		assert.Equal(t, 2.0, notifications[1].Progress)
+	})
+}
@@ -0,0 +1,13 @@
+package toolkit
+
+import "fmt"
+
+// ReadOnlyArg returns the value a string argument must take in read-only
+// mode. An empty value is replaced by want, so that gh cannot fall back to
+// a default that writes (gh api switches to POST when fields are given).
+func ReadOnlyArg(name, value, want string) (string, error) {
+	if value != "" && value != want {
+		return "", fmt.Errorf("argument %q must be %q in read-only mode, got %q", name, want, value)
+	}
+	return want, nil
+}
@@ -0,0 +1,21 @@
+package toolkit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOnlyArg(t *testing.T) {
+	value, err := ReadOnlyArg("method", "", "GET")
+	require.NoError(t, err)
+	assert.Equal(t, "GET", value, "empty value is forced")
+
+	value, err = ReadOnlyArg("method", "GET", "GET")
+	require.NoError(t, err)
+	assert.Equal(t, "GET", value)
+
+	_, err = ReadOnlyArg("method", "DELETE", "GET")
+	assert.EqualError(t, err, `argument "method" must be "GET" in read-only mode, got "DELETE"`)
+}
@@ -0,0 +1,79 @@
+package toolkit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+)
+
+// ToolError is the structured content returned for a failed tool call.
+type ToolError struct {
+	Kind     executor.ErrorKind `json:"kind"`
+	Command  string             `json:"command"`
+	ExitCode int                `json:"exit_code"`
+	Message  string             `json:"message"`
+	Stderr   string             `json:"stderr,omitempty"`
+	Stdout   string             `json:"stdout,omitempty"`
+}
+
+// ErrorResult reports a failed gh execution as a tool result with IsError set,
+// so the client sees the exit code, output and error kind instead of an
+// opaque error string. command is the gh command path, e.g. "gh pr create".
+func ErrorResult(command string, result *executor.Result, err error) *mcp.CallToolResult {
+	toolErr := ToolError{
+		Kind:    executor.KindUnknown,
+		Command: command,
+		Message: err.Error(),
+	}
+	if result != nil {
+		toolErr.Kind = executor.Classify(result.Stderr)
+		toolErr.ExitCode = result.ExitCode
+		toolErr.Stderr = result.Stderr
+		toolErr.Stdout = result.Stdout
+		if stderr := strings.TrimSpace(result.Stderr); stderr != "" {
+			toolErr.Message = stderr
+		}
+	}
+	switch {
+	case errors.Is(err, executor.ErrNoCredentials):
+		toolErr.Kind = executor.KindAuthRequired
+	case errors.Is(err, context.DeadlineExceeded):
+		toolErr.Kind = executor.KindTimeout
+		toolErr.Message = err.Error()
+	case errors.Is(err, context.Canceled):
+		toolErr.Kind = executor.KindCanceled
+		toolErr.Message = err.Error()
+	}
+	return toolErr.Result()
+}
+
+// ValidationErrorResult reports arguments rejected before gh was spawned.
+func ValidationErrorResult(command string, err error) *mcp.CallToolResult {
+	toolErr := ToolError{
+		Kind:    executor.KindValidation,
+		Command: command,
+		Message: err.Error(),
+	}
+	return toolErr.Result()
+}
+
+// Result wraps the error in a CallToolResult with a human readable summary
+// as text content and the ToolError itself as structured content.
+func (e ToolError) Result() *mcp.CallToolResult {
+	summary := fmt.Sprintf("%s failed (%s", e.Command, e.Kind)
+	if e.ExitCode != 0 {
+		summary += fmt.Sprintf(", exit %d", e.ExitCode)
+	}
+	summary += "): " + e.Message
+
+	return &mcp.CallToolResult{
+		IsError:           true,
+		Content:           []mcp.Content{&mcp.TextContent{Text: summary}},
+		StructuredContent: e,
+	}
+}
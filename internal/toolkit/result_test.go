@@ -0,0 +1,101 @@
+package toolkit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+)
+
+func resultText(t *testing.T, result *mcp.CallToolResult) string {
+	t.Helper()
+	require.Len(t, result.Content, 1)
+	text, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok, "content should be text")
+	return text.Text
+}
+
+func TestErrorResult(t *testing.T) {
+	t.Run("classifies failed command", func(t *testing.T) {
+		res := &executor.Result{
+			Stdout:   "",
+			Stderr:   "GraphQL: Could not resolve to a PullRequest with the number of 999. (repository.pullRequest)\n",
+			ExitCode: 1,
+		}
+
+		result := ErrorResult("gh pr view", res, errors.New("command failed with exit code 1"))
+
+		assert.True(t, result.IsError)
+		toolErr, ok := result.StructuredContent.(ToolError)
+		require.True(t, ok, "structured content should be a ToolError")
+		assert.Equal(t, executor.KindNotFound, toolErr.Kind)
+		assert.Equal(t, "gh pr view", toolErr.Command)
+		assert.Equal(t, 1, toolErr.ExitCode)
+		assert.Equal(t, res.Stderr, toolErr.Stderr)
+		assert.Equal(t, "GraphQL: Could not resolve to a PullRequest with the number of 999. (repository.pullRequest)", toolErr.Message)
+		assert.Equal(t, "gh pr view failed (not_found, exit 1): "+toolErr.Message, resultText(t, result))
+	})
+
+	t.Run("falls back to the error when stderr is empty", func(t *testing.T) {
+		res := &executor.Result{Stdout: "partial", ExitCode: 2}
+
+		result := ErrorResult("gh run watch", res, errors.New("command failed with exit code 2"))
+
+		toolErr := result.StructuredContent.(ToolError)
+		assert.Equal(t, executor.KindUnknown, toolErr.Kind)
+		assert.Equal(t, "partial", toolErr.Stdout)
+		assert.Equal(t, "command failed with exit code 2", toolErr.Message)
+	})
+
+	t.Run("reports timeouts", func(t *testing.T) {
+		err := fmt.Errorf("gh command timed out after 30s: %w", context.DeadlineExceeded)
+
+		result := ErrorResult("gh run watch", &executor.Result{ExitCode: -1}, err)
+
+		toolErr := result.StructuredContent.(ToolError)
+		assert.Equal(t, executor.KindTimeout, toolErr.Kind)
+		assert.Equal(t, "gh command timed out after 30s: context deadline exceeded", toolErr.Message)
+	})
+
+	t.Run("reports cancellations", func(t *testing.T) {
+		err := fmt.Errorf("gh command canceled: %w", context.Canceled)
+
+		result := ErrorResult("gh repo clone", &executor.Result{ExitCode: -1}, err)
+
+		toolErr := result.StructuredContent.(ToolError)
+		assert.Equal(t, executor.KindCanceled, toolErr.Kind)
+		assert.Equal(t, "gh command canceled: context canceled", toolErr.Message)
+	})
+
+	t.Run("reports missing session credentials", func(t *testing.T) {
+		result := ErrorResult("gh pr list", nil, executor.ErrNoCredentials)
+
+		toolErr := result.StructuredContent.(ToolError)
+		assert.Equal(t, executor.KindAuthRequired, toolErr.Kind)
+	})
+
+	t.Run("handles missing result", func(t *testing.T) {
+		result := ErrorResult("gh status", nil, errors.New("failed to start gh"))
+
+		toolErr := result.StructuredContent.(ToolError)
+		assert.Equal(t, executor.KindUnknown, toolErr.Kind)
+		assert.Zero(t, toolErr.ExitCode)
+		assert.Equal(t, "gh status failed (unknown): failed to start gh", resultText(t, result))
+	})
+}
+
+func TestValidationErrorResult(t *testing.T) {
+	result := ValidationErrorResult("gh issue list", errors.New(`invalid value "merged" for argument "state": must be one of open, closed, all`))
+
+	assert.True(t, result.IsError)
+	toolErr := result.StructuredContent.(ToolError)
+	assert.Equal(t, executor.KindValidation, toolErr.Kind)
+	assert.Equal(t, "gh issue list", toolErr.Command)
+	assert.Equal(t, `gh issue list failed (validation): invalid value "merged" for argument "state": must be one of open, closed, all`, resultText(t, result))
+}
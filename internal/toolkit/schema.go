@@ -0,0 +1,106 @@
+package toolkit
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+)
+
+// Property holds the constraints of a single tool argument that cannot be
+// expressed through struct tags.
+type Property struct {
+	Enum    []string
+	Default any
+}
+
+// InputSpec describes the constraints layered on top of the input schema
+// inferred from a tool's argument struct. Keys are JSON argument names.
+type InputSpec struct {
+	Required   []string
+	Properties map[string]Property
+}
+
+// InputSchema infers the JSON schema for T and applies the constraints in spec.
+// It panics if T cannot be represented as a schema or spec names an unknown
+// argument, mirroring mcp.AddTool's handling of invalid tool definitions.
+func InputSchema[T any](spec InputSpec) *jsonschema.Schema {
+	schema, err := jsonschema.For[T](nil)
+	if err != nil {
+		panic(fmt.Sprintf("toolkit: inferring input schema: %v", err))
+	}
+
+	for _, name := range spec.Required {
+		if _, ok := schema.Properties[name]; !ok {
+			panic(fmt.Sprintf("toolkit: required argument %q is not a property of %T", name, *new(T)))
+		}
+	}
+	schema.Required = spec.Required
+
+	for name, prop := range spec.Properties {
+		ps, ok := schema.Properties[name]
+		if !ok {
+			panic(fmt.Sprintf("toolkit: constrained argument %q is not a property of %T", name, *new(T)))
+		}
+
+		if len(prop.Enum) > 0 {
+			target := ps
+			if ps.Items != nil {
+				target = ps.Items
+			}
+			target.Enum = make([]any, len(prop.Enum))
+			for i, v := range prop.Enum {
+				target.Enum[i] = v
+			}
+		}
+
+		if prop.Default != nil {
+			data, err := json.Marshal(prop.Default)
+			if err != nil {
+				panic(fmt.Sprintf("toolkit: encoding default for %q: %v", name, err))
+			}
+			ps.Default = data
+		}
+	}
+
+	return schema
+}
+
+// Validate rejects required arguments that are present but empty, such as
+// "" or [], which the input schema accepts. Missing required arguments and
+// values outside an enum never get this far: the SDK validates every call
+// against the input schema and answers it with an invalid params error.
+func (s InputSpec) Validate(args any) error {
+	data, err := json.Marshal(args)
+	if err != nil {
+		return fmt.Errorf("encoding arguments: %w", err)
+	}
+
+	values := make(map[string]any)
+	if err := json.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("decoding arguments: %w", err)
+	}
+
+	for _, name := range s.Required {
+		if isEmpty(values[name]) {
+			return fmt.Errorf("required argument %q is empty", name)
+		}
+	}
+	return nil
+}
+
+// isEmpty reports whether a decoded JSON value carries no data.
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
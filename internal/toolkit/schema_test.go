@@ -0,0 +1,109 @@
+package toolkit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testArgs struct {
+	Query  string   `json:"query,omitempty" jsonschema:"Search query"`
+	State  string   `json:"state,omitempty" jsonschema:"Filter by state"`
+	Labels []string `json:"labels,omitempty" jsonschema:"Filter by labels"`
+	Limit  int      `json:"limit,omitempty" jsonschema:"Maximum results"`
+}
+
+var testSpec = InputSpec{
+	Required: []string{"query"},
+	Properties: map[string]Property{
+		"state":  {Enum: []string{"open", "closed"}, Default: "open"},
+		"labels": {Enum: []string{"bug", "docs"}},
+		"limit":  {Default: 30},
+	},
+}
+
+func TestInputSchema(t *testing.T) {
+	t.Run("applies required, enum and default constraints", func(t *testing.T) {
+		schema := InputSchema[testArgs](testSpec)
+
+		assert.Equal(t, "object", schema.Type)
+		assert.Equal(t, []string{"query"}, schema.Required)
+		assert.Equal(t, "Search query", schema.Properties["query"].Description)
+
+		state := schema.Properties["state"]
+		assert.Equal(t, []any{"open", "closed"}, state.Enum)
+		assert.JSONEq(t, `"open"`, string(state.Default))
+
+		labels := schema.Properties["labels"]
+		assert.Nil(t, labels.Enum, "array enums apply to items")
+		require.NotNil(t, labels.Items)
+		assert.Equal(t, []any{"bug", "docs"}, labels.Items.Enum)
+
+		assert.JSONEq(t, `30`, string(schema.Properties["limit"].Default))
+	})
+
+	t.Run("empty spec leaves every argument optional", func(t *testing.T) {
+		schema := InputSchema[testArgs](InputSpec{})
+
+		assert.Empty(t, schema.Required)
+		assert.Nil(t, schema.Properties["state"].Enum)
+	})
+
+	t.Run("schema resolves and validates", func(t *testing.T) {
+		schema := InputSchema[testArgs](testSpec)
+		resolved, err := schema.Resolve(nil)
+		require.NoError(t, err)
+
+		var valid, invalid map[string]any
+		require.NoError(t, json.Unmarshal([]byte(`{"query": "x", "state": "closed"}`), &valid))
+		require.NoError(t, json.Unmarshal([]byte(`{"query": "x", "state": "merged"}`), &invalid))
+
+		assert.NoError(t, resolved.Validate(valid))
+		assert.Error(t, resolved.Validate(invalid))
+	})
+
+	t.Run("panics on unknown argument", func(t *testing.T) {
+		assert.Panics(t, func() {
+			InputSchema[testArgs](InputSpec{Required: []string{"missing"}})
+		})
+		assert.Panics(t, func() {
+			InputSchema[testArgs](InputSpec{Properties: map[string]Property{"missing": {Enum: []string{"a"}}}})
+		})
+	})
+}
+
+func TestInputSpec_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    testArgs
+		wantErr string
+	}{
+		{
+			name: "valid arguments",
+			args: testArgs{Query: "is:open", State: "open", Labels: []string{"bug"}},
+		},
+		{
+			name: "optional enum argument omitted",
+			args: testArgs{Query: "is:open"},
+		},
+		{
+			name:    "empty required argument",
+			args:    testArgs{Query: "", State: "open"},
+			wantErr: `required argument "query" is empty`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testSpec.Validate(tt.args)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.wantErr, err.Error())
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package toolkit
+
+import "time"
+
+// Timeout resolves the timeout for a tool call. A positive timeout_seconds
+// argument wins over the subcommand's declared timeout; zero for both lets
+// the executor apply its default. The executor caps the result either way.
+func Timeout(argSeconds, defaultSeconds int) time.Duration {
+	if argSeconds > 0 {
+		return time.Duration(argSeconds) * time.Second
+	}
+	return time.Duration(defaultSeconds) * time.Second
+}
@@ -0,0 +1,15 @@
+package toolkit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeout(t *testing.T) {
+	assert.Equal(t, time.Duration(0), Timeout(0, 0), "no override leaves the executor default")
+	assert.Equal(t, 30*time.Second, Timeout(0, 30), "declared timeout")
+	assert.Equal(t, 2*time.Minute, Timeout(120, 30), "argument overrides declared timeout")
+	assert.Equal(t, 30*time.Second, Timeout(-5, 30), "non-positive argument is ignored")
+}
@@ -0,0 +1,111 @@
+package toolkit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// WorkingDir resolves the cwd argument of a tool call to the directory gh
+// runs in. An empty cwd returns "", leaving the executor's default. Otherwise
+// cwd must be an absolute path to an existing directory and, when the client
+// supports roots, lie within one of them.
+func WorkingDir(ctx context.Context, req *mcp.CallToolRequest, cwd string) (string, error) {
+	if cwd == "" {
+		return "", nil
+	}
+	if !filepath.IsAbs(cwd) {
+		return "", fmt.Errorf("cwd %q must be an absolute path", cwd)
+	}
+
+	// Symlinks are resolved so that a link inside a root cannot point gh
+	// at a directory outside it.
+	dir, err := filepath.EvalSymlinks(cwd)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("cwd %q does not exist", cwd)
+	}
+	if err != nil {
+		return "", fmt.Errorf("cwd %q: %w", cwd, err)
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return "", fmt.Errorf("cwd %q: %w", cwd, err)
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("cwd %q is not a directory", cwd)
+	}
+
+	roots, supported, err := clientRoots(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("listing client roots: %w", err)
+	}
+	if !supported {
+		return dir, nil
+	}
+	for _, root := range roots {
+		if withinDir(dir, root) {
+			return dir, nil
+		}
+	}
+	return "", fmt.Errorf("cwd %q is outside the client's roots", cwd)
+}
+
+// clientRoots returns the local directories of the client's roots, and
+// whether the client supports roots at all.
+func clientRoots(ctx context.Context, req *mcp.CallToolRequest) ([]string, bool, error) {
+	if req == nil || req.Session == nil {
+		return nil, false, nil
+	}
+	params := req.Session.InitializeParams()
+	if params == nil || params.Capabilities == nil || params.Capabilities.RootsV2 == nil {
+		return nil, false, nil
+	}
+
+	result, err := req.Session.ListRoots(ctx, nil)
+	if err != nil {
+		return nil, true, err
+	}
+	var dirs []string
+	for _, root := range result.Roots {
+		if dir, ok := rootDir(root.URI); ok {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs, true, nil
+}
+
+// rootDir converts a file:// root URI to a local directory. Roots with any
+// other scheme cannot contain a working directory and are skipped.
+func rootDir(uri string) (string, bool) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" || u.Path == "" {
+		return "", false
+	}
+	path := u.Path
+	// file:///C:/src parses to the path /C:/src on Windows.
+	if runtime.GOOS == "windows" {
+		path = strings.TrimPrefix(path, "/")
+	}
+	dir := filepath.Clean(filepath.FromSlash(path))
+	if resolved, err := filepath.EvalSymlinks(dir); err == nil {
+		dir = resolved
+	}
+	return dir, true
+}
+
+// withinDir reports whether path is root or a directory below it.
+func withinDir(path, root string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,112 @@
+package toolkit
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkingDir(t *testing.T) {
+	base, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	checkout := filepath.Join(base, "checkout")
+	outside := filepath.Join(base, "outside")
+	require.NoError(t, os.MkdirAll(filepath.Join(checkout, "sub"), 0o755))
+	require.NoError(t, os.Mkdir(outside, 0o755))
+	file := filepath.Join(checkout, "README.md")
+	require.NoError(t, os.WriteFile(file, nil, 0o644))
+
+	t.Run("without a session", func(t *testing.T) {
+		dir, err := WorkingDir(context.Background(), nil, "")
+		require.NoError(t, err)
+		assert.Empty(t, dir, "no cwd leaves the executor default")
+
+		dir, err = WorkingDir(context.Background(), nil, outside)
+		require.NoError(t, err)
+		assert.Equal(t, outside, dir)
+
+		_, err = WorkingDir(context.Background(), nil, "checkout")
+		assert.ErrorContains(t, err, "must be an absolute path")
+
+		_, err = WorkingDir(context.Background(), nil, filepath.Join(base, "missing"))
+		assert.ErrorContains(t, err, "does not exist")
+
+		_, err = WorkingDir(context.Background(), nil, file)
+		assert.ErrorContains(t, err, "is not a directory")
+	})
+
+	t.Run("within client roots", func(t *testing.T) {
+		rootURI := (&url.URL{Scheme: "file", Path: filepath.ToSlash(checkout)}).String()
+		client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+		client.AddRoots(&mcp.Root{URI: rootURI})
+
+		resolve := callWorkingDir(t, client)
+
+		dir, err := resolve(checkout)
+		require.NoError(t, err)
+		assert.Equal(t, checkout, dir)
+
+		dir, err = resolve(filepath.Join(checkout, "sub"))
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(checkout, "sub"), dir)
+
+		_, err = resolve(outside)
+		assert.ErrorContains(t, err, "is outside the client's roots")
+
+		link := filepath.Join(checkout, "escape")
+		if err := os.Symlink(outside, link); err == nil {
+			_, err = resolve(link)
+			assert.ErrorContains(t, err, "is outside the client's roots", "symlinks are resolved")
+		}
+	})
+
+	t.Run("client without roots support", func(t *testing.T) {
+		client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, &mcp.ClientOptions{
+			Capabilities: &mcp.ClientCapabilities{},
+		})
+
+		dir, err := callWorkingDir(t, client)(outside)
+		require.NoError(t, err)
+		assert.Equal(t, outside, dir)
+	})
+}
+
+// callWorkingDir connects client to a server whose tool resolves its cwd
+// argument with WorkingDir, and returns a function calling that tool.
+func callWorkingDir(t *testing.T, client *mcp.Client) func(cwd string) (string, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	type args struct {
+		Cwd string `json:"cwd"`
+	}
+	var (
+		dir    string
+		dirErr error
+	)
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "status"}, func(ctx context.Context, req *mcp.CallToolRequest, args args) (*mcp.CallToolResult, any, error) {
+		dir, dirErr = WorkingDir(ctx, req, args.Cwd)
+		return &mcp.CallToolResult{}, nil, nil
+	})
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+	session, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+
+	return func(cwd string) (string, error) {
+		_, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "status", Arguments: map[string]any{"cwd": cwd}})
+		require.NoError(t, err)
+		return dir, dirErr
+	}
+}
@@ -0,0 +1,151 @@
+// Package toolset selects which tools the server registers, by command
+// group (toolset) and by tool name.
+package toolset
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+)
+
+// Access is what a tool can change.
+type Access string
+
+// Access levels. Tools without an access level are treated as write tools.
+const (
+	// AccessRead tools change nothing, on GitHub or locally.
+	AccessRead Access = "read"
+	// AccessWrite tools create or modify data.
+	AccessWrite Access = "write"
+	// AccessDestructive tools delete data.
+	AccessDestructive Access = "destructive"
+)
+
+// Tool is a tool and the function that registers it.
+type Tool struct {
+	Name   string
+	Access Access
+
+	// ReadOnlyConstrained tools restrict their arguments in read-only mode
+	// so that they only read (e.g. gh api is limited to GET), and are
+	// registered there whatever their access.
+	ReadOnlyConstrained bool
+
+	// SensitiveOutput tools print credentials (e.g. gh auth token). They
+	// are never registered in read-only mode, which must not hand out
+	// credentials even though the tools change nothing.
+	SensitiveOutput bool
+
+	Register func(server *mcp.Server, exec executor.Runner)
+}
+
+// readOnly reports whether the tool may be registered in read-only mode.
+func (t Tool) readOnly() bool {
+	return (t.Access == AccessRead || t.ReadOnlyConstrained) && !t.SensitiveOutput
+}
+
+// Toolset is a named group of tools, such as the tools of one gh command.
+type Toolset struct {
+	Name  string
+	Tools []Tool
+}
+
+// all enables every toolset in Selection.Toolsets.
+const all = "all"
+
+// Selection chooses the tools to register. A tool is registered when its
+// toolset is enabled or its name matches one of Tools, unless its name
+// matches one of ExcludeTools or ReadOnly is set and the tool can write.
+//
+// Toolsets are enabled by Toolsets and disabled by ExcludeToolsets. Without
+// Toolsets every toolset is enabled, unless Tools picks individual tools.
+type Selection struct {
+	Toolsets        []string
+	ExcludeToolsets []string
+
+	// Tools and ExcludeTools hold path.Match globs of tool names,
+	// e.g. gh_repo_* or gh_repo_delete.
+	Tools        []string
+	ExcludeTools []string
+
+	// ReadOnly registers only tools that cannot change anything.
+	ReadOnly bool
+}
+
+// ParseList splits a comma-separated flag value, dropping blank entries.
+func ParseList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// Validate rejects selections naming unknown toolsets or malformed globs.
+func (s Selection) Validate(toolsets []Toolset) error {
+	for _, name := range slices.Concat(s.Toolsets, s.ExcludeToolsets) {
+		known := name == all || slices.ContainsFunc(toolsets, func(ts Toolset) bool { return ts.Name == name })
+		if !known {
+			return fmt.Errorf("unknown toolset %q", name)
+		}
+	}
+	for _, pattern := range slices.Concat(s.Tools, s.ExcludeTools) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid tool pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// Includes reports whether the selection registers tool of toolset.
+func (s Selection) Includes(toolset string, tool Tool) bool {
+	if s.ReadOnly && !tool.readOnly() {
+		return false
+	}
+	if matchAny(s.ExcludeTools, tool.Name) {
+		return false
+	}
+	return s.enabled(toolset) || matchAny(s.Tools, tool.Name)
+}
+
+// enabled reports whether every tool of toolset is selected.
+func (s Selection) enabled(toolset string) bool {
+	if slices.Contains(s.ExcludeToolsets, all) || slices.Contains(s.ExcludeToolsets, toolset) {
+		return false
+	}
+	if len(s.Toolsets) == 0 {
+		return len(s.Tools) == 0
+	}
+	return slices.Contains(s.Toolsets, all) || slices.Contains(s.Toolsets, toolset)
+}
+
+// matchAny reports whether name matches any of the glob patterns.
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Register registers the selected tools of toolsets and returns their names.
+func Register(server *mcp.Server, exec executor.Runner, toolsets []Toolset, selection Selection) []string {
+	var registered []string
+	for _, ts := range toolsets {
+		for _, tool := range ts.Tools {
+			if selection.Includes(ts.Name, tool) {
+				tool.Register(server, exec)
+				registered = append(registered, tool.Name)
+			}
+		}
+	}
+	return registered
+}
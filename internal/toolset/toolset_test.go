@@ -0,0 +1,82 @@
+package toolset
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+)
+
+func TestParseList(t *testing.T) {
+	assert.Nil(t, ParseList(""))
+	assert.Equal(t, []string{"pr", "issue", "run"}, ParseList("pr, issue,,run "))
+}
+
+func TestSelection_Includes(t *testing.T) {
+	tests := []struct {
+		name      string
+		selection Selection
+		toolset   string
+		tool      Tool
+		want      bool
+	}{
+		{name: "everything by default", toolset: "repo", tool: Tool{Name: "gh_repo_delete"}, want: true},
+		{name: "enabled toolset", selection: Selection{Toolsets: []string{"pr", "issue"}}, toolset: "pr", tool: Tool{Name: "gh_pr_list"}, want: true},
+		{name: "toolset not enabled", selection: Selection{Toolsets: []string{"pr", "issue"}}, toolset: "repo", tool: Tool{Name: "gh_repo_view"}, want: false},
+		{name: "all toolsets", selection: Selection{Toolsets: []string{"all"}}, toolset: "repo", tool: Tool{Name: "gh_repo_view"}, want: true},
+		{name: "excluded toolset", selection: Selection{ExcludeToolsets: []string{"codespace"}}, toolset: "codespace", tool: Tool{Name: "gh_codespace_list"}, want: false},
+		{name: "other toolset with exclusions", selection: Selection{ExcludeToolsets: []string{"codespace"}}, toolset: "pr", tool: Tool{Name: "gh_pr_list"}, want: true},
+		{name: "tool pattern adds to toolsets", selection: Selection{Toolsets: []string{"pr"}, Tools: []string{"gh_repo_view"}}, toolset: "repo", tool: Tool{Name: "gh_repo_view"}, want: true},
+		{name: "tool patterns alone pick tools", selection: Selection{Tools: []string{"gh_repo_*"}}, toolset: "repo", tool: Tool{Name: "gh_repo_view"}, want: true},
+		{name: "tool patterns alone exclude other tools", selection: Selection{Tools: []string{"gh_repo_*"}}, toolset: "pr", tool: Tool{Name: "gh_pr_list"}, want: false},
+		{name: "excluded tool", selection: Selection{ExcludeTools: []string{"gh_*_delete"}}, toolset: "repo", tool: Tool{Name: "gh_repo_delete"}, want: false},
+		{name: "excluded tool beats pattern", selection: Selection{Tools: []string{"gh_repo_*"}, ExcludeTools: []string{"gh_repo_delete"}}, toolset: "repo", tool: Tool{Name: "gh_repo_delete"}, want: false},
+		{name: "read tool in read-only mode", selection: Selection{ReadOnly: true}, toolset: "repo", tool: Tool{Name: "gh_repo_view", Access: AccessRead}, want: true},
+		{name: "write tool in read-only mode", selection: Selection{ReadOnly: true}, toolset: "repo", tool: Tool{Name: "gh_repo_edit", Access: AccessWrite}, want: false},
+		{name: "destructive tool in read-only mode", selection: Selection{ReadOnly: true, Tools: []string{"gh_repo_delete"}}, toolset: "repo", tool: Tool{Name: "gh_repo_delete", Access: AccessDestructive}, want: false},
+		{name: "tool without access in read-only mode", selection: Selection{ReadOnly: true}, toolset: "repo", tool: Tool{Name: "gh_repo_sync"}, want: false},
+		{name: "sensitive output tool in read-only mode", selection: Selection{ReadOnly: true}, toolset: "auth", tool: Tool{Name: "gh_auth_token", Access: AccessRead, SensitiveOutput: true}, want: false},
+		{name: "constrained tool in read-only mode", selection: Selection{ReadOnly: true}, toolset: "api", tool: Tool{Name: "gh_api_request", Access: AccessWrite, ReadOnlyConstrained: true}, want: true},
+		{name: "tool pattern in excluded toolset", selection: Selection{ExcludeToolsets: []string{"all"}, Tools: []string{"gh_auth_status"}}, toolset: "auth", tool: Tool{Name: "gh_auth_status"}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.selection.Includes(tt.toolset, tt.tool))
+		})
+	}
+}
+
+func TestSelection_Validate(t *testing.T) {
+	toolsets := []Toolset{{Name: "pr"}, {Name: "repo"}}
+
+	assert.NoError(t, Selection{Toolsets: []string{"pr", "all"}, ExcludeTools: []string{"gh_repo_*"}}.Validate(toolsets))
+	assert.ErrorContains(t, Selection{Toolsets: []string{"prs"}}.Validate(toolsets), `unknown toolset "prs"`)
+	assert.ErrorContains(t, Selection{ExcludeToolsets: []string{"ssh-key"}}.Validate(toolsets), `unknown toolset "ssh-key"`)
+	assert.ErrorContains(t, Selection{Tools: []string{"gh_repo_["}}.Validate(toolsets), `invalid tool pattern "gh_repo_["`)
+}
+
+func TestRegister(t *testing.T) {
+	var called []string
+	tool := func(name string) Tool {
+		return Tool{Name: name, Register: func(*mcp.Server, executor.Runner) {
+			called = append(called, name)
+		}}
+	}
+	toolsets := []Toolset{
+		{Name: "pr", Tools: []Tool{tool("gh_pr_list"), tool("gh_pr_view")}},
+		{Name: "repo", Tools: []Tool{tool("gh_repo_view"), tool("gh_repo_delete")}},
+	}
+
+	registered := Register(nil, nil, toolsets, Selection{
+		Toolsets:     []string{"repo"},
+		Tools:        []string{"gh_pr_view"},
+		ExcludeTools: []string{"gh_repo_delete"},
+	})
+
+	require.Equal(t, []string{"gh_pr_view", "gh_repo_view"}, registered)
+	assert.Equal(t, registered, called)
+}
@@ -2,16 +2,23 @@ package main
 
 import (
 	"bytes"
+	"cmp"
 	"fmt"
 	"go/format"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"text/template"
+	"time"
 )
 
 const (
-	typeString = "string"
+	typeString  = "string"
+	typeInteger = "integer"
+	typeBoolean = "boolean"
 )
 
 // GenerateCode generates Go code for all command definitions.
@@ -28,6 +35,13 @@ func GenerateCode(definitions []CommandDefinition, outputDir string) error {
 		}
 	}
 
+	// Generate argv golden tests for each command
+	for _, def := range definitions {
+		if err := generateTestFile(def, outputDir); err != nil {
+			return fmt.Errorf("failed to generate tests for %s: %w", def.Command, err)
+		}
+	}
+
 	// Generate registry file
 	if err := generateRegistry(definitions, outputDir); err != nil {
 		return fmt.Errorf("failed to generate registry: %w", err)
@@ -66,6 +80,33 @@ func generateCommandFile(def CommandDefinition, outputDir string) error {
 	return nil
 }
 
+// generateTestFile generates the argv golden test for a single command group.
+func generateTestFile(def CommandDefinition, outputDir string) error {
+	tmpl, err := template.New("test").Funcs(templateFuncs()).Parse(testTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, def); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to format %s test: %v\n", def.Command, err)
+		formatted = buf.Bytes()
+	}
+
+	filename := filepath.Join(outputDir, fmt.Sprintf("%s_gen_test.go", def.Command))
+	if err := os.WriteFile(filename, formatted, 0600); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	fmt.Printf("Generated %s\n", filename)
+	return nil
+}
+
 // generateRegistry generates the registry.go file that registers all tools.
 func generateRegistry(definitions []CommandDefinition, outputDir string) error {
 	tmpl, err := template.New("registry").Funcs(templateFuncs()).Parse(registryTemplate)
@@ -96,16 +137,70 @@ func generateRegistry(definitions []CommandDefinition, outputDir string) error {
 // templateFuncs returns custom template functions.
 func templateFuncs() template.FuncMap {
 	return template.FuncMap{
-		"toTitle":        toTitle,
-		"toCamel":        toCamel,
-		"toSnake":        toSnake,
-		"goType":         goType,
-		"jsonTag":        jsonTag,
-		"schemaTag":      schemaTag,
-		"hasPositional":  hasPositional,
-		"nonPositional":  nonPositional,
-		"positionalArgs": positionalArgs,
+		"toTitle":          toTitle,
+		"toCamel":          toCamel,
+		"toSnake":          toSnake,
+		"goType":           goType,
+		"jsonTag":          jsonTag,
+		"schemaTag":        schemaTag,
+		"hasPositional":    hasPositional,
+		"nonPositional":    nonPositional,
+		"positionalArgs":   positionalArgs,
+		"requiredArgs":     requiredArgs,
+		"constrainedArgs":  constrainedArgs,
+		"goLiteral":        goLiteral,
+		"needsFmt":         needsFmt,
+		"hasParam":         hasParam,
+		"hasStringParam":   hasStringParam,
+		"hasBoolParam":     hasBoolParam,
+		"readOnlyArgs":     readOnlyArgs,
+		"toolTitle":        toolTitle,
+		"openWorld":        openWorld,
+		"destructiveHint":  destructiveHint,
+		"confirms":         confirms,
+		"confirmCondition": confirmCondition,
+		"needsSlices":      needsSlices,
+		"needsMaps":        needsMaps,
+		"separator":        separator,
+		"timeoutSeconds":   timeoutSeconds,
+		"stdinArgs":        stdinArgs,
+		"toolName":         toolName,
+		"argvLiteral":      argvLiteral,
+		"argvString":       argvString,
+		"sampleArgs":       sampleArgs,
+	}
+}
+
+// toolName returns the MCP tool name for a subcommand.
+func toolName(command string, sub Subcommand) string {
+	return fmt.Sprintf("gh_%s_%s", command, toSnake(sub.Name))
+}
+
+// commandArgv returns the gh argv prefix invoked by a subcommand's tool.
+func commandArgv(command string, sub Subcommand) []string {
+	switch {
+	case len(sub.Argv) > 0:
+		return sub.Argv
+	case sub.Root:
+		return []string{command}
+	default:
+		return []string{command, sub.Name}
+	}
+}
+
+// argvLiteral renders the argv prefix as the elements of a Go string slice literal.
+func argvLiteral(command string, sub Subcommand) string {
+	argv := commandArgv(command, sub)
+	quoted := make([]string, len(argv))
+	for i, arg := range argv {
+		quoted[i] = strconv.Quote(arg)
 	}
+	return strings.Join(quoted, ", ")
+}
+
+// argvString renders the argv prefix as it would be typed after "gh".
+func argvString(command string, sub Subcommand) string {
+	return strings.Join(commandArgv(command, sub), " ")
 }
 
 // toTitle converts string to TitleCase.
@@ -140,15 +235,21 @@ func goType(param Parameter) string {
 	switch param.Type {
 	case typeString:
 		return typeString
-	case "integer":
+	case typeInteger:
+		if param.Nullable {
+			return "*int"
+		}
 		return "int"
-	case "boolean":
+	case typeBoolean:
+		if param.Nullable {
+			return "*bool"
+		}
 		return "bool"
 	case "array":
 		itemType := typeString
 		if param.ItemType != "" {
 			switch param.ItemType {
-			case "integer":
+			case typeInteger:
 				itemType = "int"
 			}
 		}
@@ -174,8 +275,8 @@ func schemaTag(param Parameter) string {
 	description := strings.ReplaceAll(param.Description, `"`, `'`)
 
 	// The jsonschema tag should contain just the description text
-	// Required fields are inferred from json:"field" vs json:"field,omitempty"
-	// Enum validation is not supported via struct tags in google/jsonschema-go
+	// Required, enum and default constraints are emitted separately as a
+	// toolkit.InputSpec, since google/jsonschema-go cannot read them from tags
 	return fmt.Sprintf(`jsonschema:"%s"`, description)
 }
 
@@ -210,3 +311,216 @@ func positionalArgs(params []Parameter) []Parameter {
 	}
 	return result
 }
+
+// requiredArgs returns parameters that must be supplied by the caller.
+func requiredArgs(params []Parameter) []Parameter {
+	var result []Parameter
+	for _, param := range params {
+		if param.Required {
+			result = append(result, param)
+		}
+	}
+	return result
+}
+
+// stdinArgs returns parameters whose value is piped to gh on standard input.
+func stdinArgs(params []Parameter) []Parameter {
+	var result []Parameter
+	for _, param := range params {
+		if param.Stdin {
+			result = append(result, param)
+		}
+	}
+	return result
+}
+
+// constrainedArgs returns parameters with enum or default constraints.
+func constrainedArgs(params []Parameter) []Parameter {
+	var result []Parameter
+	for _, param := range params {
+		if len(param.Enum) > 0 || param.Default != nil {
+			result = append(result, param)
+		}
+	}
+	return result
+}
+
+// goLiteral renders a YAML scalar as a Go literal.
+func goLiteral(v any) string {
+	return fmt.Sprintf("%#v", v)
+}
+
+// needsFmt reports whether the generated handlers format flag values with fmt.
+func needsFmt(def CommandDefinition) bool {
+	for _, sub := range def.Subcommands {
+		for _, param := range nonPositional(sub.Parameters) {
+			switch {
+			case param.Type == typeInteger, param.Type == "map":
+				return true
+			case param.Type == typeBoolean && param.Nullable:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// findParameter returns the subcommand parameter with the given name.
+func findParameter(sub Subcommand, name string) (Parameter, bool) {
+	for _, param := range sub.Parameters {
+		if param.Name == name {
+			return param, true
+		}
+	}
+	return Parameter{}, false
+}
+
+// hasParam reports whether a subcommand declares a parameter with the given name.
+func hasParam(sub Subcommand, name string) bool {
+	_, ok := findParameter(sub, name)
+	return ok
+}
+
+// readOnlyArgs returns the parameters constrained in read-only mode.
+func readOnlyArgs(params []Parameter) []Parameter {
+	var result []Parameter
+	for _, param := range params {
+		if param.ReadOnlyValue != "" {
+			result = append(result, param)
+		}
+	}
+	return result
+}
+
+// toolTitle returns the human-readable title of a subcommand's tool.
+func toolTitle(sub Subcommand) string {
+	if sub.Title != "" {
+		return sub.Title
+	}
+	return sub.Description
+}
+
+// destructiveHint reports whether a subcommand's tool may overwrite or
+// discard data: every write that is not declared additive, and every
+// destructive one.
+func destructiveHint(sub Subcommand) bool {
+	return sub.Access != accessRead && !sub.Additive
+}
+
+// confirms reports whether a subcommand's tool may ask for confirmation
+// with --confirm-destructive, and so takes a confirm token.
+func confirms(sub Subcommand) bool {
+	return sub.Access == accessDestructive || len(sub.ConfirmUnless) > 0
+}
+
+// confirmCondition returns the Go expression that is true when a call of a
+// confirm_unless subcommand needs confirmation: when any named argument is
+// not one of its listed values.
+func confirmCondition(sub Subcommand) string {
+	var conds []string
+	for _, name := range slices.Sorted(maps.Keys(sub.ConfirmUnless)) {
+		conds = append(conds, fmt.Sprintf("!slices.Contains(%s, args.%s)", goLiteral(sub.ConfirmUnless[name]), toTitle(name)))
+	}
+	return strings.Join(conds, " || ")
+}
+
+// needsSlices reports whether generated code for a command uses the slices
+// package.
+func needsSlices(def CommandDefinition) bool {
+	if needsMaps(def) {
+		return true
+	}
+	for _, sub := range def.Subcommands {
+		if len(sub.ConfirmUnless) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// separator returns what joins the key and value of a map parameter's
+// entries.
+func separator(param Parameter) string {
+	return cmp.Or(param.Separator, "=")
+}
+
+// needsMaps reports whether generated code for a command has map flags,
+// whose keys it sorts so that the command line is deterministic.
+func needsMaps(def CommandDefinition) bool {
+	for _, sub := range def.Subcommands {
+		for _, param := range nonPositional(sub.Parameters) {
+			if param.Type == "map" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// openWorld reports whether a subcommand reaches GitHub.
+func openWorld(sub Subcommand) bool {
+	return sub.OpenWorld == nil || *sub.OpenWorld
+}
+
+// hasStringParam reports whether a subcommand declares a string parameter
+// with the given name.
+func hasStringParam(sub Subcommand, name string) bool {
+	param, ok := findParameter(sub, name)
+	return ok && param.Type == typeString
+}
+
+// hasBoolParam reports whether a subcommand declares a non-nullable boolean
+// parameter with the given name.
+func hasBoolParam(sub Subcommand, name string) bool {
+	param, ok := findParameter(sub, name)
+	return ok && param.Type == typeBoolean && !param.Nullable
+}
+
+// timeoutSeconds returns the subcommand's declared timeout in seconds, or 0
+// when it uses the server default. Timeouts are validated by the parser.
+func timeoutSeconds(sub Subcommand) int {
+	if sub.Timeout == "" {
+		return 0
+	}
+	timeout, err := time.ParseDuration(sub.Timeout)
+	if err != nil {
+		return 0
+	}
+	return int(timeout / time.Second)
+}
+
+// sampleArgs renders representative arguments for every parameter of a
+// subcommand as a Go map literal, for the generated argv golden tests.
+// Values are derived from each parameter's type: "sample-<name>" (or the
+// first enum value) for strings, 1 for integers, true for booleans, one
+// element for arrays and one entry for maps.
+func sampleArgs(sub Subcommand) string {
+	entries := make([]string, 0, len(sub.Parameters))
+	for _, param := range sub.Parameters {
+		entries = append(entries, fmt.Sprintf("%q: %s", toSnake(param.Name), sampleValue(param)))
+	}
+	return "map[string]any{" + strings.Join(entries, ", ") + "}"
+}
+
+// sampleValue renders a representative value of a parameter as a Go literal.
+func sampleValue(param Parameter) string {
+	sample := strconv.Quote("sample-" + strings.ReplaceAll(toSnake(param.Name), "_", "-"))
+	if len(param.Enum) > 0 {
+		sample = strconv.Quote(param.Enum[0])
+	}
+	switch param.Type {
+	case typeInteger:
+		return "1"
+	case typeBoolean:
+		return "true"
+	case "array":
+		if param.ItemType == typeInteger {
+			return "[]any{1}"
+		}
+		return "[]any{" + sample + "}"
+	case "map":
+		return `map[string]any{"key": "value"}`
+	default:
+		return sample
+	}
+}
@@ -76,6 +76,16 @@ func TestGenerateCode(t *testing.T) {
 		assert.Contains(t, contentStr, `"test", "list"`)
 		assert.Contains(t, contentStr, `"test", "create"`)
 
+		// Verify the argv golden test calls every tool with sample arguments
+		testContent, err := os.ReadFile(filepath.Join(tmpDir, "test_gen_test.go"))
+		require.NoError(t, err)
+		testStr := string(testContent)
+		assert.Contains(t, testStr, "// Code generated by tools/gen. DO NOT EDIT.")
+		assert.Contains(t, testStr, "func TestTestArgv(t *testing.T) {")
+		assert.Contains(t, testStr, `checkArgvGolden(t, "test", []argvCase{`)
+		assert.Contains(t, testStr, `{Tool: "gh_test_list", Args: map[string]any{"limit": 1}},`)
+		assert.Contains(t, testStr, `{Tool: "gh_test_create", Args: map[string]any{"name": "sample-name"}},`)
+
 		// Verify registry file content
 		registryContent, err := os.ReadFile(registryFile)
 		require.NoError(t, err)
@@ -221,7 +231,7 @@ func TestGenerateCommandFile(t *testing.T) {
 		assert.Contains(t, contentStr, "package generated")
 		assert.Contains(t, contentStr, "func RegisterExampleRunTool")
 		assert.Contains(t, contentStr, "mcp.AddTool")
-		assert.Contains(t, contentStr, "exec.Execute")
+		assert.Contains(t, contentStr, `exec.Run(ctx, "gh_example_run", executor.Options{`)
 
 		// Verify code compiles (basic syntax check)
 		assert.NotContains(t, contentStr, "Warning: failed to format")
@@ -276,6 +286,500 @@ func TestGenerateCommandFile(t *testing.T) {
 		// Flags should use if statements
 		assert.Contains(t, contentStr, "if args.Force {")
 	})
+
+	t.Run("forwards explicit zero and false for nullable parameters", func(t *testing.T) {
+		def := CommandDefinition{
+			Command:     "repo",
+			Description: "Repositories",
+			Subcommands: []Subcommand{
+				{
+					Name:        "edit",
+					Description: "Edit a repository",
+					Parameters: []Parameter{
+						{Name: "enable_issues", Type: "boolean", Flag: "--enable-issues", Nullable: true, Description: "Enable issues"},
+						{Name: "interval", Type: "integer", Flag: "--interval", Nullable: true, Description: "Interval"},
+						{Name: "web", Type: "boolean", Flag: "--web", Description: "Open in browser"},
+					},
+				},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateCommandFile(def, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "repo_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Regexp(t, `EnableIssues\s+\*bool`, contentStr)
+		assert.Regexp(t, `Interval\s+\*int`, contentStr)
+		assert.Contains(t, contentStr, "if args.EnableIssues != nil {")
+		assert.Contains(t, contentStr, `cmd = append(cmd, fmt.Sprintf("--enable-issues=%t", *args.EnableIssues))`)
+		assert.Contains(t, contentStr, "if args.Interval != nil {")
+		assert.Contains(t, contentStr, `cmd = append(cmd, "--interval", fmt.Sprintf("%d", *args.Interval))`)
+		assert.Contains(t, contentStr, "if args.Web {", "non-nullable booleans keep presence semantics")
+	})
+
+	t.Run("emits input constraints", func(t *testing.T) {
+		def := CommandDefinition{
+			Command:     "run",
+			Description: "Runs",
+			Subcommands: []Subcommand{
+				{
+					Name:        "list",
+					Description: "List runs",
+					Parameters: []Parameter{
+						{
+							Name:        "workflow",
+							Type:        "string",
+							Description: "Workflow name",
+							Positional:  true,
+							Required:    true,
+						},
+						{
+							Name:        "status",
+							Type:        "string",
+							Flag:        "--status",
+							Description: "Filter by status",
+							Enum:        []string{"queued", "completed"},
+							Default:     "completed",
+						},
+					},
+				},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateCommandFile(def, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "run_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Contains(t, contentStr, "var runListInputSpec = toolkit.InputSpec{")
+		assert.Contains(t, contentStr, `Required: []string{"workflow"},`)
+		assert.Contains(t, contentStr, `"status": {Enum: []string{"queued", "completed"}, Default: "completed"},`)
+		assert.Contains(t, contentStr, "InputSchema: toolkit.InputSchema[RunListArgs](runListInputSpec),")
+		assert.Contains(t, contentStr, "if err := runListInputSpec.Validate(args); err != nil {")
+	})
+
+	t.Run("leaves enum checks to schema validation", func(t *testing.T) {
+		def := CommandDefinition{
+			Command:     "run",
+			Description: "Manage workflow runs",
+			Subcommands: []Subcommand{
+				{
+					Name:        "list",
+					Description: "List runs",
+					Parameters: []Parameter{
+						{Name: "status", Type: "string", Flag: "--status", Enum: []string{"queued", "completed"}},
+					},
+				},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateCommandFile(def, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "run_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Contains(t, contentStr, `"status": {Enum: []string{"queued", "completed"}},`)
+		assert.NotContains(t, contentStr, "runListInputSpec.Validate", "only required arguments are checked by the handler")
+	})
+
+	t.Run("pipes stdin parameters instead of passing them in argv", func(t *testing.T) {
+		def := CommandDefinition{
+			Command:     "auth",
+			Description: "Auth",
+			Subcommands: []Subcommand{
+				{
+					Name:        "login",
+					Description: "Log in",
+					Parameters: []Parameter{
+						{Name: "token", Type: "string", Flag: "--with-token", Stdin: true, Description: "Token"},
+					},
+				},
+				{
+					Name:        "set",
+					Description: "Set a secret",
+					Parameters: []Parameter{
+						{Name: "body", Type: "string", Stdin: true, Description: "Secret value"},
+					},
+				},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateCommandFile(def, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "auth_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Contains(t, contentStr, `cmd = append(cmd, "--with-token")`)
+		assert.NotContains(t, contentStr, "args.Token)", "token must not be passed in argv")
+		assert.NotContains(t, contentStr, "args.Body)", "body must not be passed in argv")
+		assert.Contains(t, contentStr, "Stdin:       args.Token,")
+		assert.Contains(t, contentStr, "Stdin:       args.Body,")
+	})
+
+	t.Run("passes timeouts, output sensitivity and streaming to the executor", func(t *testing.T) {
+		def := CommandDefinition{
+			Command:     "run",
+			Description: "Runs",
+			Subcommands: []Subcommand{
+				{Name: "watch", Description: "Watch a run", Timeout: "1h"},
+				{Name: "list", Description: "List runs"},
+				{Name: "token", Description: "Print a token", SensitiveOutput: true},
+				{Name: "download", Description: "Download artifacts", Streaming: true},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateCommandFile(def, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "run_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Contains(t, contentStr, `TimeoutSeconds int    `+"`"+`json:"timeout_seconds,omitempty"`)
+		assert.Contains(t, contentStr, "Timeout:     toolkit.Timeout(args.TimeoutSeconds, 3600),")
+		assert.Contains(t, contentStr, "Timeout:     toolkit.Timeout(args.TimeoutSeconds, 0),")
+		assert.Equal(t, 4, strings.Count(contentStr, "exec.Run(ctx, "))
+		assert.Equal(t, 1, strings.Count(contentStr, "SensitiveOutput: true,"))
+		assert.Equal(t, 1, strings.Count(contentStr, "OnLine:      toolkit.Progress(ctx, req),"))
+	})
+
+	t.Run("registers the command's tools as a group", func(t *testing.T) {
+		def := CommandDefinition{
+			Command:     "gpg-key",
+			Description: "GPG keys",
+			Subcommands: []Subcommand{
+				{Name: "list", Description: "List GPG keys"},
+				{Name: "add", Description: "Add a GPG key"},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateCommandFile(def, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "gpg-key_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Contains(t, contentStr, "func RegisterGpgKeyTools(server *mcp.Server, exec executor.Runner) {\n\tRegisterGpgKeyListTool(server, exec)\n\tRegisterGpgKeyAddTool(server, exec)\n}")
+	})
+
+	t.Run("constrains arguments in read-only mode", func(t *testing.T) {
+		def := CommandDefinition{
+			Command:     "api",
+			Description: "API requests",
+			Subcommands: []Subcommand{
+				{
+					Name:        "request",
+					Description: "Make a request",
+					Root:        true,
+					Parameters: []Parameter{
+						{Name: "method", Type: "string", Flag: "--method", ReadOnlyValue: "GET"},
+					},
+				},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateCommandFile(def, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "api_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Contains(t, contentStr, "if exec.ReadOnly() {")
+		assert.Contains(t, contentStr, `if args.Method, err = toolkit.ReadOnlyArg("method", args.Method, "GET"); err != nil {`)
+		assert.Contains(t, contentStr, `return defaults.Annotate(toolkit.ValidationErrorResult("gh api", err)), nil, nil`)
+	})
+
+	t.Run("emits tool annotations", func(t *testing.T) {
+		local := false
+		def := CommandDefinition{
+			Command:     "issue",
+			Description: "Manage issues",
+			Subcommands: []Subcommand{
+				{Name: "list", Description: "List issues", Title: "List Issues", Access: accessRead},
+				{Name: "close", Description: "Close issue", Access: accessWrite, Idempotent: true},
+				{Name: "comment", Description: "Comment on issue", Access: accessWrite, Additive: true},
+				{Name: "delete", Description: "Delete issue", Title: "Delete Issue", Access: accessDestructive, OpenWorld: &local},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateCommandFile(def, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "issue_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Contains(t, contentStr, `Title:           "List Issues",
+			ReadOnlyHint:    true,
+			DestructiveHint: toolkit.Bool(false),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(true),`)
+		assert.Contains(t, contentStr, `Title:           "Close issue",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  true,
+			OpenWorldHint:   toolkit.Bool(true),`, "title defaults to the description; writes may overwrite data")
+		assert.Contains(t, contentStr, `Title:           "Comment on issue",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(false),`, "additive writes are not destructive")
+		assert.Contains(t, contentStr, `Title:           "Delete Issue",
+			ReadOnlyHint:    false,
+			DestructiveHint: toolkit.Bool(true),
+			IdempotentHint:  false,
+			OpenWorldHint:   toolkit.Bool(false),`)
+	})
+
+	t.Run("confirms destructive commands before running them", func(t *testing.T) {
+		def := CommandDefinition{
+			Command:     "release",
+			Description: "Manage releases",
+			Subcommands: []Subcommand{
+				{Name: "delete", Description: "Delete a release", Access: accessDestructive},
+				{Name: "edit", Description: "Edit a release", Access: accessWrite},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateCommandFile(def, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "release_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Equal(t, 1, strings.Count(contentStr, `json:"confirm_token,omitempty"`), "only destructive tools take a confirm token")
+		assert.Equal(t, 1, strings.Count(contentStr, "if exec.ConfirmDestructive() {"))
+		assert.Contains(t, contentStr, `if result := toolkit.Confirm(ctx, req, "gh release delete", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {`)
+	})
+
+	t.Run("confirms writes unless arguments take listed values", func(t *testing.T) {
+		def := CommandDefinition{
+			Command:     "api",
+			Description: "Make API requests",
+			Subcommands: []Subcommand{
+				{
+					Name:          "request",
+					Description:   "Make a request",
+					Root:          true,
+					Access:        accessWrite,
+					ConfirmUnless: map[string][]string{"method": {"GET", "HEAD"}},
+					Parameters: []Parameter{
+						{Name: "endpoint", Type: "string", Positional: true, Required: true},
+						{Name: "method", Type: "string", Flag: "--method"},
+					},
+				},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateCommandFile(def, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "api_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Contains(t, contentStr, `"slices"`)
+		assert.Contains(t, contentStr, `json:"confirm_token,omitempty"`)
+		assert.Contains(t, contentStr, `if exec.ConfirmDestructive() && (!slices.Contains([]string{"GET", "HEAD"}, args.Method)) {`)
+		assert.Contains(t, contentStr, `if result := toolkit.Confirm(ctx, req, "gh api", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, ""); result != nil {`)
+	})
+
+	t.Run("runs in the directory given by cwd", func(t *testing.T) {
+		def := CommandDefinition{
+			Command:     "pr",
+			Description: "Pull requests",
+			Subcommands: []Subcommand{
+				{Name: "status", Description: "Show status of relevant pull requests"},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateCommandFile(def, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "pr_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Contains(t, contentStr, `Cwd            string `+"`"+`json:"cwd,omitempty"`)
+		assert.Contains(t, contentStr, "dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))")
+		assert.Contains(t, contentStr, `return defaults.Annotate(toolkit.ValidationErrorResult("gh pr status", err)), nil, nil`)
+		assert.Contains(t, contentStr, "Dir:         dir,")
+	})
+
+	t.Run("runs with the session's credentials", func(t *testing.T) {
+		def := CommandDefinition{
+			Command:     "pr",
+			Description: "Pull requests",
+			Subcommands: []Subcommand{
+				{Name: "list", Description: "List pull requests"},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateCommandFile(def, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "pr_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Contains(t, contentStr, "creds, err := session.Credentials(req)")
+		assert.Contains(t, contentStr, `return defaults.Annotate(toolkit.ErrorResult("gh pr list", nil, err)), nil, nil`)
+		assert.Contains(t, contentStr, "Credentials: creds,")
+	})
+
+	t.Run("applies session defaults the call leaves unset", func(t *testing.T) {
+		def := CommandDefinition{
+			Command:     "issue",
+			Description: "Issues",
+			Subcommands: []Subcommand{
+				{
+					Name:        "list",
+					Description: "List issues",
+					Parameters: []Parameter{
+						{Name: "repo", Type: "string", Flag: "--repo"},
+					},
+				},
+				{Name: "status", Description: "Show issue status"},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateCommandFile(def, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "issue_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Contains(t, contentStr, "defaults := session.For(req).Without(session.Defaults{Repo: args.Repo, Cwd: args.Cwd})")
+		assert.Contains(t, contentStr, "defaults := session.For(req).Without(session.Defaults{Cwd: args.Cwd})")
+		assert.Equal(t, 2, strings.Count(contentStr, "Env:         defaults.Env(),"))
+		assert.Equal(t, 4, strings.Count(contentStr, `return defaults.Annotate(toolkit.ErrorResult(`))
+		assert.Equal(t, 2, strings.Count(contentStr, "return defaults.Annotate(&mcp.CallToolResult{"))
+	})
+
+	t.Run("emits output schema for json output", func(t *testing.T) {
+		def := CommandDefinition{
+			Command:     "label",
+			Description: "Labels",
+			Subcommands: []Subcommand{
+				{
+					Name:        "list",
+					Description: "List labels",
+					Parameters: []Parameter{
+						{Name: "json", Type: "array", Flag: "--json", Description: "Output JSON"},
+						{Name: "jq", Type: "string", Flag: "--jq", Description: "Filter JSON"},
+					},
+					JSONOutput: &JSONOutput{
+						Array: true,
+						Fields: []JSONField{
+							{Name: "name", Type: "string", Description: "Label name"},
+							{Name: "description", Type: "string", Description: "Label description", Nullable: true},
+						},
+					},
+				},
+				{
+					Name:        "delete",
+					Description: "Delete a label",
+				},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateCommandFile(def, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "label_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Contains(t, contentStr, "var labelListOutputSpec = toolkit.OutputSpec{")
+		assert.Contains(t, contentStr, "Array: true,")
+		assert.Contains(t, contentStr, `{Name: "name", Type: "string", Description: "Label name"},`)
+		assert.Contains(t, contentStr, `{Name: "description", Type: "string", Description: "Label description", Nullable: true},`)
+		assert.Contains(t, contentStr, "OutputSchema: toolkit.OutputSchema(labelListOutputSpec),")
+		assert.Contains(t, contentStr, `if len(args.Json) > 0 && args.Jq == "" {`)
+		assert.Contains(t, contentStr, "return defaults.Annotate(toolkit.JSONResult(result.Stdout, true)), nil, nil")
+		assert.Equal(t, 1, strings.Count(contentStr, "toolkit.TextResult(result.Stdout)"), "only tools with an output schema return text as structured content")
+		assert.NotContains(t, contentStr, "labelDeleteOutputSpec")
+		assert.Equal(t, 1, strings.Count(contentStr, "OutputSchema:"))
+	})
+
+	t.Run("requests default json fields unless text is asked for", func(t *testing.T) {
+		def := CommandDefinition{
+			Command:     "label",
+			Description: "Labels",
+			Subcommands: []Subcommand{
+				{
+					Name:        "list",
+					Description: "List labels",
+					Parameters: []Parameter{
+						{Name: "json", Type: "array", Flag: "--json", Description: "Output JSON"},
+						{Name: "web", Type: "boolean", Flag: "--web", Description: "Open in browser"},
+						formatParameter,
+					},
+					DefaultJSONFields: []string{"name", "color"},
+					JSONOutput: &JSONOutput{
+						Array:  true,
+						Fields: []JSONField{{Name: "name", Type: "string"}, {Name: "color", Type: "string"}},
+					},
+				},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateCommandFile(def, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "label_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Contains(t, contentStr, `var labelListDefaultJSONFields = []string{"name", "color"}`)
+		assert.Contains(t, contentStr, `Format string`)
+		assert.Contains(t, contentStr, `"format": {Enum: []string{"json", "text"}, Default: "json"},`)
+		assert.Contains(t, contentStr, `if len(args.Json) == 0 && args.Format != "text" && !args.Web {`)
+		assert.Contains(t, contentStr, "args.Json = labelListDefaultJSONFields")
+		assert.Contains(t, contentStr, `if len(args.Json) > 0 && args.Format != "text" {`)
+		assert.NotContains(t, contentStr, `"--format"`, "synthetic parameters are not passed to gh")
+	})
 }
 
 func TestGenerateRegistry(t *testing.T) {
@@ -314,18 +818,60 @@ func TestGenerateRegistry(t *testing.T) {
 		assert.Contains(t, contentStr, "package generated")
 		assert.Contains(t, contentStr, "func RegisterAllTools(")
 		assert.Contains(t, contentStr, "server *mcp.Server")
-		assert.Contains(t, contentStr, "exec *executor.Executor")
-
-		// Verify all tools are registered
-		assert.Contains(t, contentStr, "RegisterCmd1ListTool(server, exec)")
-		assert.Contains(t, contentStr, "RegisterCmd1CreateTool(server, exec)")
-		assert.Contains(t, contentStr, "RegisterCmd2RunTool(server, exec)")
-
-		// Count the number of Register*Tool function calls (not just any "Register")
-		registrationCount := strings.Count(contentStr, "RegisterCmd1ListTool(server, exec)") +
-			strings.Count(contentStr, "RegisterCmd1CreateTool(server, exec)") +
-			strings.Count(contentStr, "RegisterCmd2RunTool(server, exec)")
-		assert.Equal(t, 3, registrationCount, "Should have 3 tool registration calls")
+		assert.Contains(t, contentStr, "exec executor.Runner")
+
+		// Verify all toolsets are registered
+		assert.Contains(t, contentStr, "RegisterCmd1Tools(server, exec)")
+		assert.Contains(t, contentStr, "RegisterCmd2Tools(server, exec)")
+
+		// Verify the toolsets list every tool
+		assert.Contains(t, contentStr, "var Toolsets = []toolset.Toolset{")
+		assert.Contains(t, contentStr, `{Name: "cmd1", Tools: []toolset.Tool{`)
+		assert.Contains(t, contentStr, `{Name: "gh_cmd1_list", Register: RegisterCmd1ListTool},`)
+		assert.Contains(t, contentStr, `{Name: "gh_cmd1_create", Register: RegisterCmd1CreateTool},`)
+		assert.Contains(t, contentStr, `{Name: "cmd2", Tools: []toolset.Tool{`)
+		assert.Contains(t, contentStr, `{Name: "gh_cmd2_run", Register: RegisterCmd2RunTool},`)
+		assert.Equal(t, 3, strings.Count(contentStr, "Register: "), "Should list 3 tools")
+	})
+
+	t.Run("carries access into the registry", func(t *testing.T) {
+		definitions := []CommandDefinition{
+			{
+				Command: "api",
+				Subcommands: []Subcommand{
+					{
+						Name:       "request",
+						Access:     accessWrite,
+						Parameters: []Parameter{{Name: "method", Type: "string", Flag: "--method", ReadOnlyValue: "GET"}},
+					},
+				},
+			},
+			{
+				Command: "repo",
+				Subcommands: []Subcommand{
+					{Name: "view", Access: accessRead},
+					{Name: "delete", Access: accessDestructive},
+				},
+			},
+			{
+				Command:     "auth",
+				Subcommands: []Subcommand{{Name: "token", Access: accessRead, SensitiveOutput: true}},
+			},
+		}
+
+		tmpDir := t.TempDir()
+
+		err := generateRegistry(definitions, tmpDir)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "registry_gen.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Contains(t, contentStr, `{Name: "gh_api_request", Access: "write", ReadOnlyConstrained: true, Register: RegisterApiRequestTool},`)
+		assert.Contains(t, contentStr, `{Name: "gh_repo_view", Access: "read", Register: RegisterRepoViewTool},`)
+		assert.Contains(t, contentStr, `{Name: "gh_repo_delete", Access: "destructive", Register: RegisterRepoDeleteTool},`)
+		assert.Contains(t, contentStr, `{Name: "gh_auth_token", Access: "read", SensitiveOutput: true, Register: RegisterAuthTokenTool},`)
 	})
 
 	t.Run("generates empty registry for no definitions", func(t *testing.T) {
@@ -390,8 +936,201 @@ func TestGenerateCode_RealDefinitions(t *testing.T) {
 			if !strings.Contains(file, "registry_gen.go") {
 				assert.Contains(t, contentStr, `"context"`)
 				assert.Contains(t, contentStr, `"github.com/khalideidoo/mcp-go-gh/internal/executor"`)
+				assert.Contains(t, contentStr, `"github.com/khalideidoo/mcp-go-gh/internal/toolkit"`)
 				assert.Contains(t, contentStr, `"github.com/modelcontextprotocol/go-sdk/mcp"`)
 			}
 		}
 	})
 }
+
+// TestCommandArgv_RealDefinitions pins the exact gh argv prefix built for
+// every tool, so a tool name can never silently leak into the command line.
+func TestCommandArgv_RealDefinitions(t *testing.T) {
+	definitionsDir := "../../internal/commands/definitions"
+
+	if _, err := os.Stat(definitionsDir); os.IsNotExist(err) {
+		t.Skip("Definitions directory not found")
+	}
+
+	definitions, err := ParseDefinitions(definitionsDir)
+	require.NoError(t, err)
+
+	expected := map[string][]string{
+		"gh_alias_list":                 {"alias", "list"},
+		"gh_alias_set":                  {"alias", "set"},
+		"gh_alias_delete":               {"alias", "delete"},
+		"gh_alias_import":               {"alias", "import"},
+		"gh_api_request":                {"api"},
+		"gh_attestation_verify":         {"attestation", "verify"},
+		"gh_attestation_download":       {"attestation", "download"},
+		"gh_attestation_trusted_root":   {"attestation", "trusted-root"},
+		"gh_auth_login":                 {"auth", "login"},
+		"gh_auth_logout":                {"auth", "logout"},
+		"gh_auth_refresh":               {"auth", "refresh"},
+		"gh_auth_status":                {"auth", "status"},
+		"gh_auth_token":                 {"auth", "token"},
+		"gh_auth_setup_git":             {"auth", "setup-git"},
+		"gh_browse_browse":              {"browse"},
+		"gh_cache_list":                 {"cache", "list"},
+		"gh_cache_delete":               {"cache", "delete"},
+		"gh_codespace_list":             {"codespace", "list"},
+		"gh_codespace_create":           {"codespace", "create"},
+		"gh_codespace_delete":           {"codespace", "delete"},
+		"gh_codespace_view":             {"codespace", "view"},
+		"gh_codespace_stop":             {"codespace", "stop"},
+		"gh_codespace_ssh":              {"codespace", "ssh"},
+		"gh_codespace_logs":             {"codespace", "logs"},
+		"gh_codespace_ports_list":       {"codespace", "ports"},
+		"gh_codespace_edit":             {"codespace", "edit"},
+		"gh_codespace_rebuild":          {"codespace", "rebuild"},
+		"gh_codespace_code":             {"codespace", "code"},
+		"gh_codespace_jupyter":          {"codespace", "jupyter"},
+		"gh_codespace_cp":               {"codespace", "cp"},
+		"gh_codespace_ports_visibility": {"codespace", "ports", "visibility"},
+		"gh_completion_completion":      {"completion"},
+		"gh_config_list":                {"config", "list"},
+		"gh_config_get":                 {"config", "get"},
+		"gh_config_set":                 {"config", "set"},
+		"gh_config_clear_cache":         {"config", "clear-cache"},
+		"gh_extension_list":             {"extension", "list"},
+		"gh_extension_install":          {"extension", "install"},
+		"gh_extension_remove":           {"extension", "remove"},
+		"gh_extension_upgrade":          {"extension", "upgrade"},
+		"gh_extension_search":           {"extension", "search"},
+		"gh_extension_create":           {"extension", "create"},
+		"gh_extension_exec":             {"extension", "exec"},
+		"gh_extension_browse":           {"extension", "browse"},
+		"gh_gist_create":                {"gist", "create"},
+		"gh_gist_list":                  {"gist", "list"},
+		"gh_gist_view":                  {"gist", "view"},
+		"gh_gist_edit":                  {"gist", "edit"},
+		"gh_gist_delete":                {"gist", "delete"},
+		"gh_gist_clone":                 {"gist", "clone"},
+		"gh_gpg-key_list":               {"gpg-key", "list"},
+		"gh_gpg-key_add":                {"gpg-key", "add"},
+		"gh_gpg-key_delete":             {"gpg-key", "delete"},
+		"gh_issue_create":               {"issue", "create"},
+		"gh_issue_list":                 {"issue", "list"},
+		"gh_issue_view":                 {"issue", "view"},
+		"gh_issue_close":                {"issue", "close"},
+		"gh_issue_comment":              {"issue", "comment"},
+		"gh_issue_delete":               {"issue", "delete"},
+		"gh_issue_edit":                 {"issue", "edit"},
+		"gh_issue_lock":                 {"issue", "lock"},
+		"gh_issue_pin":                  {"issue", "pin"},
+		"gh_issue_reopen":               {"issue", "reopen"},
+		"gh_issue_status":               {"issue", "status"},
+		"gh_issue_transfer":             {"issue", "transfer"},
+		"gh_issue_unlock":               {"issue", "unlock"},
+		"gh_issue_unpin":                {"issue", "unpin"},
+		"gh_label_create":               {"label", "create"},
+		"gh_label_list":                 {"label", "list"},
+		"gh_label_edit":                 {"label", "edit"},
+		"gh_label_delete":               {"label", "delete"},
+		"gh_label_clone":                {"label", "clone"},
+		"gh_org_list":                   {"org", "list"},
+		"gh_pr_create":                  {"pr", "create"},
+		"gh_pr_list":                    {"pr", "list"},
+		"gh_pr_view":                    {"pr", "view"},
+		"gh_pr_close":                   {"pr", "close"},
+		"gh_pr_merge":                   {"pr", "merge"},
+		"gh_pr_checkout":                {"pr", "checkout"},
+		"gh_pr_checks":                  {"pr", "checks"},
+		"gh_pr_diff":                    {"pr", "diff"},
+		"gh_pr_comment":                 {"pr", "comment"},
+		"gh_pr_edit":                    {"pr", "edit"},
+		"gh_pr_ready":                   {"pr", "ready"},
+		"gh_pr_reopen":                  {"pr", "reopen"},
+		"gh_pr_review":                  {"pr", "review"},
+		"gh_pr_status":                  {"pr", "status"},
+		"gh_project_create":             {"project", "create"},
+		"gh_project_list":               {"project", "list"},
+		"gh_project_view":               {"project", "view"},
+		"gh_project_edit":               {"project", "edit"},
+		"gh_project_close":              {"project", "close"},
+		"gh_project_delete":             {"project", "delete"},
+		"gh_project_copy":               {"project", "copy"},
+		"gh_project_field_list":         {"project", "field-list"},
+		"gh_project_field_create":       {"project", "field-create"},
+		"gh_project_field_delete":       {"project", "field-delete"},
+		"gh_project_item_list":          {"project", "item-list"},
+		"gh_project_item_add":           {"project", "item-add"},
+		"gh_project_item_create":        {"project", "item-create"},
+		"gh_project_item_edit":          {"project", "item-edit"},
+		"gh_project_item_delete":        {"project", "item-delete"},
+		"gh_project_item_archive":       {"project", "item-archive"},
+		"gh_project_link":               {"project", "link"},
+		"gh_project_unlink":             {"project", "unlink"},
+		"gh_project_mark_template":      {"project", "mark-template"},
+		"gh_release_create":             {"release", "create"},
+		"gh_release_list":               {"release", "list"},
+		"gh_release_view":               {"release", "view"},
+		"gh_release_delete":             {"release", "delete"},
+		"gh_release_download":           {"release", "download"},
+		"gh_release_upload":             {"release", "upload"},
+		"gh_release_edit":               {"release", "edit"},
+		"gh_repo_create":                {"repo", "create"},
+		"gh_repo_list":                  {"repo", "list"},
+		"gh_repo_view":                  {"repo", "view"},
+		"gh_repo_clone":                 {"repo", "clone"},
+		"gh_repo_fork":                  {"repo", "fork"},
+		"gh_repo_delete":                {"repo", "delete"},
+		"gh_repo_archive":               {"repo", "archive"},
+		"gh_repo_unarchive":             {"repo", "unarchive"},
+		"gh_repo_edit":                  {"repo", "edit"},
+		"gh_repo_rename":                {"repo", "rename"},
+		"gh_repo_sync":                  {"repo", "sync"},
+		"gh_repo_deploy_key_list":       {"repo", "deploy-key", "list"},
+		"gh_repo_deploy_key_add":        {"repo", "deploy-key", "add"},
+		"gh_repo_deploy_key_delete":     {"repo", "deploy-key", "delete"},
+		"gh_repo_autolink_list":         {"repo", "autolink", "list"},
+		"gh_repo_autolink_create":       {"repo", "autolink", "create"},
+		"gh_repo_autolink_view":         {"repo", "autolink", "view"},
+		"gh_repo_autolink_delete":       {"repo", "autolink", "delete"},
+		"gh_repo_gitignore_list":        {"repo", "gitignore", "list"},
+		"gh_repo_gitignore_view":        {"repo", "gitignore", "view"},
+		"gh_repo_license_list":          {"repo", "license", "list"},
+		"gh_repo_license_view":          {"repo", "license", "view"},
+		"gh_ruleset_list":               {"ruleset", "list"},
+		"gh_ruleset_view":               {"ruleset", "view"},
+		"gh_ruleset_check":              {"ruleset", "check"},
+		"gh_run_list":                   {"run", "list"},
+		"gh_run_view":                   {"run", "view"},
+		"gh_run_watch":                  {"run", "watch"},
+		"gh_run_rerun":                  {"run", "rerun"},
+		"gh_run_cancel":                 {"run", "cancel"},
+		"gh_run_delete":                 {"run", "delete"},
+		"gh_run_download":               {"run", "download"},
+		"gh_search_repos":               {"search", "repos"},
+		"gh_search_issues":              {"search", "issues"},
+		"gh_search_prs":                 {"search", "prs"},
+		"gh_secret_list":                {"secret", "list"},
+		"gh_secret_set":                 {"secret", "set"},
+		"gh_secret_remove":              {"secret", "remove"},
+		"gh_ssh-key_list":               {"ssh-key", "list"},
+		"gh_ssh-key_add":                {"ssh-key", "add"},
+		"gh_ssh-key_delete":             {"ssh-key", "delete"},
+		"gh_status_status":              {"status"},
+		"gh_variable_set":               {"variable", "set"},
+		"gh_variable_list":              {"variable", "list"},
+		"gh_variable_get":               {"variable", "get"},
+		"gh_variable_delete":            {"variable", "delete"},
+		"gh_workflow_list":              {"workflow", "list"},
+		"gh_workflow_view":              {"workflow", "view"},
+		"gh_workflow_run":               {"workflow", "run"},
+		"gh_workflow_enable":            {"workflow", "enable"},
+		"gh_workflow_disable":           {"workflow", "disable"},
+	}
+
+	actual := make(map[string][]string)
+	for _, def := range definitions {
+		for _, sub := range def.Subcommands {
+			name := toolName(def.Command, sub)
+			_, duplicate := actual[name]
+			assert.False(t, duplicate, "tool %s is defined more than once", name)
+			actual[name] = commandArgv(def.Command, sub)
+		}
+	}
+
+	assert.Equal(t, expected, actual)
+}
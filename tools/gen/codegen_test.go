@@ -166,6 +166,16 @@ func TestGoType(t *testing.T) {
 			param:    Parameter{Type: "boolean"},
 			expected: "bool",
 		},
+		{
+			name:     "nullable integer type",
+			param:    Parameter{Type: "integer", Nullable: true},
+			expected: "*int",
+		},
+		{
+			name:     "nullable boolean type",
+			param:    Parameter{Type: "boolean", Nullable: true},
+			expected: "*bool",
+		},
 		{
 			name:     "array of strings",
 			param:    Parameter{Type: "array", ItemType: "string"},
@@ -348,6 +358,174 @@ func TestPositionalArgs(t *testing.T) {
 	assert.Equal(t, "target", result[1].Name)
 }
 
+func TestRequiredArgs(t *testing.T) {
+	params := []Parameter{
+		{Name: "endpoint", Positional: true, Required: true},
+		{Name: "method"},
+		{Name: "owner", Required: true},
+	}
+
+	result := requiredArgs(params)
+	require.Len(t, result, 2)
+	assert.Equal(t, "endpoint", result[0].Name)
+	assert.Equal(t, "owner", result[1].Name)
+}
+
+func TestStdinArgs(t *testing.T) {
+	params := []Parameter{
+		{Name: "name", Type: "string", Positional: true},
+		{Name: "body", Type: "string", Stdin: true},
+		{Name: "env", Type: "string", Flag: "--env"},
+	}
+
+	result := stdinArgs(params)
+	require.Len(t, result, 1)
+	assert.Equal(t, "body", result[0].Name)
+	assert.Empty(t, stdinArgs(params[2:]))
+}
+
+func TestConstrainedArgs(t *testing.T) {
+	params := []Parameter{
+		{Name: "state", Enum: []string{"open", "closed"}},
+		{Name: "title"},
+		{Name: "limit", Default: 30},
+	}
+
+	result := constrainedArgs(params)
+	require.Len(t, result, 2)
+	assert.Equal(t, "state", result[0].Name)
+	assert.Equal(t, "limit", result[1].Name)
+}
+
+func TestGoLiteral(t *testing.T) {
+	assert.Equal(t, `"open"`, goLiteral("open"))
+	assert.Equal(t, "30", goLiteral(30))
+	assert.Equal(t, "true", goLiteral(true))
+}
+
+func TestNeedsFmt(t *testing.T) {
+	def := func(params ...Parameter) CommandDefinition {
+		return CommandDefinition{Command: "x", Subcommands: []Subcommand{{Name: "y", Parameters: params}}}
+	}
+
+	assert.False(t, needsFmt(def(Parameter{Name: "title", Type: "string", Flag: "--title"})))
+	assert.False(t, needsFmt(def(Parameter{Name: "draft", Type: "boolean", Flag: "--draft"})))
+	assert.False(t, needsFmt(def(Parameter{Name: "number", Type: "integer", Positional: true})))
+	assert.True(t, needsFmt(def(Parameter{Name: "limit", Type: "integer", Flag: "--limit"})))
+	assert.True(t, needsFmt(def(Parameter{Name: "field", Type: "map", Flag: "--field"})))
+	assert.True(t, needsFmt(def(Parameter{Name: "draft", Type: "boolean", Flag: "--draft", Nullable: true})))
+}
+
+func TestHasParam(t *testing.T) {
+	sub := Subcommand{Name: "list", Parameters: []Parameter{{Name: "json"}, {Name: "jq"}}}
+
+	assert.True(t, hasParam(sub, "json"))
+	assert.True(t, hasParam(sub, "jq"))
+	assert.False(t, hasParam(sub, "template"))
+}
+
+func TestReadOnlyArgs(t *testing.T) {
+	params := []Parameter{
+		{Name: "endpoint", Type: typeString, Positional: true},
+		{Name: "method", Type: typeString, Flag: "--method", ReadOnlyValue: "GET"},
+	}
+
+	got := readOnlyArgs(params)
+	require.Len(t, got, 1)
+	assert.Equal(t, "method", got[0].Name)
+	assert.Empty(t, readOnlyArgs(params[:1]))
+}
+
+func TestHasStringParam(t *testing.T) {
+	sub := Subcommand{Name: "list", Parameters: []Parameter{
+		{Name: "repo", Type: typeString},
+		{Name: "owner", Type: "array"},
+	}}
+
+	assert.True(t, hasStringParam(sub, "repo"))
+	assert.False(t, hasStringParam(sub, "owner"))
+	assert.False(t, hasStringParam(sub, "hostname"))
+}
+
+func TestTimeoutSeconds(t *testing.T) {
+	assert.Equal(t, 0, timeoutSeconds(Subcommand{Name: "list"}))
+	assert.Equal(t, 30, timeoutSeconds(Subcommand{Name: "list", Timeout: "30s"}))
+	assert.Equal(t, 3600, timeoutSeconds(Subcommand{Name: "watch", Timeout: "1h"}))
+	assert.Equal(t, 90, timeoutSeconds(Subcommand{Name: "clone", Timeout: "1m30s"}))
+}
+
+func TestToolName(t *testing.T) {
+	assert.Equal(t, "gh_pr_create", toolName("pr", Subcommand{Name: "create"}))
+	assert.Equal(t, "gh_project_field_list", toolName("project", Subcommand{Name: "field-list"}))
+	assert.Equal(t, "gh_auth_setup_git", toolName("auth", Subcommand{Name: "setup_git", Argv: []string{"auth", "setup-git"}}))
+	assert.Equal(t, "gh_status_status", toolName("status", Subcommand{Name: "status", Root: true}))
+}
+
+func TestCommandArgv(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		sub      Subcommand
+		expected []string
+	}{
+		{
+			name:     "defaults to command and subcommand name",
+			command:  "pr",
+			sub:      Subcommand{Name: "create"},
+			expected: []string{"pr", "create"},
+		},
+		{
+			name:     "root subcommand invokes the command alone",
+			command:  "status",
+			sub:      Subcommand{Name: "status", Root: true},
+			expected: []string{"status"},
+		},
+		{
+			name:     "explicit argv overrides the name",
+			command:  "auth",
+			sub:      Subcommand{Name: "setup_git", Argv: []string{"auth", "setup-git"}},
+			expected: []string{"auth", "setup-git"},
+		},
+		{
+			name:     "explicit argv takes precedence over root",
+			command:  "api",
+			sub:      Subcommand{Name: "request", Root: true, Argv: []string{"api", "graphql"}},
+			expected: []string{"api", "graphql"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, commandArgv(tt.command, tt.sub))
+		})
+	}
+}
+
+func TestArgvLiteral(t *testing.T) {
+	assert.Equal(t, `"pr", "create"`, argvLiteral("pr", Subcommand{Name: "create"}))
+	assert.Equal(t, `"status"`, argvLiteral("status", Subcommand{Name: "status", Root: true}))
+	assert.Equal(t, "status", argvString("status", Subcommand{Name: "status", Root: true}))
+	assert.Equal(t, "auth setup-git", argvString("auth", Subcommand{Name: "setup_git", Argv: []string{"auth", "setup-git"}}))
+}
+
+func TestSampleArgs(t *testing.T) {
+	sub := Subcommand{Name: "edit", Parameters: []Parameter{
+		{Name: "number", Type: "string", Positional: true},
+		{Name: "body-file", Type: "string", Flag: "--body-file"},
+		{Name: "state", Type: "string", Flag: "--state", Enum: []string{"open", "closed"}},
+		{Name: "limit", Type: "integer", Flag: "--limit"},
+		{Name: "draft", Type: "boolean", Flag: "--draft", Nullable: true},
+		{Name: "label", Type: "array", Flag: "--label"},
+		{Name: "app", Type: "array", Flag: "--app", Enum: []string{"actions", "dependabot"}},
+		{Name: "ids", Type: "array", ItemType: "integer", Flag: "--id"},
+		{Name: "field", Type: "map", Flag: "-f"},
+	}}
+
+	assert.Equal(t, `map[string]any{"number": "sample-number", "body_file": "sample-body-file", "state": "open", "limit": 1, "draft": true, `+
+		`"label": []any{"sample-label"}, "app": []any{"actions"}, "ids": []any{1}, "field": map[string]any{"key": "value"}}`, sampleArgs(sub))
+	assert.Equal(t, "map[string]any{}", sampleArgs(Subcommand{Name: "status"}))
+}
+
 func TestTemplateFuncs(t *testing.T) {
 	t.Run("returns all required template functions", func(t *testing.T) {
 		funcs := templateFuncs()
@@ -362,6 +540,23 @@ func TestTemplateFuncs(t *testing.T) {
 			"hasPositional",
 			"nonPositional",
 			"positionalArgs",
+			"requiredArgs",
+			"constrainedArgs",
+			"goLiteral",
+			"needsFmt",
+			"hasParam",
+			"hasStringParam",
+			"readOnlyArgs",
+			"destructiveHint",
+			"confirms",
+			"confirmCondition",
+			"needsSlices",
+			"timeoutSeconds",
+			"stdinArgs",
+			"toolName",
+			"argvLiteral",
+			"argvString",
+			"sampleArgs",
 		}
 
 		for _, name := range requiredFuncs {
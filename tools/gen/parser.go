@@ -2,8 +2,12 @@ package main
 
 import (
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -41,5 +45,350 @@ func parseDefinitionFile(path string) (CommandDefinition, error) {
 		return CommandDefinition{}, fmt.Errorf("failed to unmarshal YAML: %w", err)
 	}
 
+	subcommands, err := flattenSubcommands(def.Command, "", []string{def.Command}, def.Subcommands)
+	if err != nil {
+		return CommandDefinition{}, err
+	}
+	def.Subcommands = subcommands
+
+	if err := checkToolNames(def); err != nil {
+		return CommandDefinition{}, err
+	}
+
+	if err := checkParameters(def); err != nil {
+		return CommandDefinition{}, err
+	}
+
+	if err := checkJSONOutput(def); err != nil {
+		return CommandDefinition{}, err
+	}
+
+	if err := addFormatParameters(def); err != nil {
+		return CommandDefinition{}, err
+	}
+
+	if err := checkTimeouts(def); err != nil {
+		return CommandDefinition{}, err
+	}
+
+	if err := checkAccess(def); err != nil {
+		return CommandDefinition{}, err
+	}
+
+	if err := checkReservedParameters(def); err != nil {
+		return CommandDefinition{}, err
+	}
+
+	if err := checkConfirmUnless(def); err != nil {
+		return CommandDefinition{}, err
+	}
+
 	return def, nil
 }
+
+// flattenSubcommands expands nested subcommand groups into leaf subcommands.
+// Leaves inside a group are renamed <group>-<name> and get an explicit argv
+// of the group's argv followed by their own name.
+func flattenSubcommands(command, prefix string, parentArgv []string, subs []Subcommand) ([]Subcommand, error) {
+	if err := checkSiblingNames(prefix, subs); err != nil {
+		return nil, err
+	}
+
+	var result []Subcommand
+	for _, sub := range subs {
+		name := sub.Name
+		if prefix != "" {
+			name = prefix + "-" + sub.Name
+		}
+
+		argv := sub.Argv
+		if len(argv) == 0 {
+			if prefix == "" {
+				argv = commandArgv(command, sub)
+			} else {
+				argv = append(append([]string{}, parentArgv...), sub.Name)
+			}
+		}
+
+		if len(sub.Subcommands) == 0 {
+			if prefix != "" {
+				sub.Argv = argv
+			}
+			sub.Name = name
+			result = append(result, sub)
+			continue
+		}
+
+		if attrs := leafAttributes(sub); len(attrs) > 0 {
+			return nil, fmt.Errorf("subcommand group %q cannot declare %s", name, strings.Join(attrs, ", "))
+		}
+
+		leaves, err := flattenSubcommands(command, name, argv, sub.Subcommands)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, leaves...)
+	}
+	return result, nil
+}
+
+// checkSiblingNames rejects subcommands declared twice at the same level,
+// such as a leaf and a group sharing a name; give the leaf its own name and
+// an explicit argv instead.
+func checkSiblingNames(prefix string, subs []Subcommand) error {
+	seen := make(map[string]bool, len(subs))
+	for _, sub := range subs {
+		if seen[sub.Name] {
+			name := sub.Name
+			if prefix != "" {
+				name = prefix + "-" + sub.Name
+			}
+			return fmt.Errorf("subcommand %q is declared more than once", name)
+		}
+		seen[sub.Name] = true
+	}
+	return nil
+}
+
+// leafAttributes lists the YAML keys set on sub that only apply to leaf
+// subcommands. Groups are not tools, so their leaves would silently lose
+// them.
+func leafAttributes(sub Subcommand) []string {
+	var attrs []string
+	add := func(set bool, key string) {
+		if set {
+			attrs = append(attrs, key)
+		}
+	}
+	add(sub.Root, "root")
+	add(len(sub.Parameters) > 0, "parameters")
+	add(sub.JSONOutput != nil, "json_output")
+	add(sub.Access != "", "access")
+	add(sub.Title != "", "title")
+	add(sub.Idempotent, "idempotent")
+	add(sub.Additive, "additive")
+	add(len(sub.ConfirmUnless) > 0, "confirm_unless")
+	add(sub.OpenWorld != nil, "open_world")
+	add(sub.Timeout != "", "timeout")
+	add(sub.SensitiveOutput, "sensitive_output")
+	add(sub.Streaming, "streaming")
+	add(len(sub.DefaultJSONFields) > 0, "default_json_fields")
+	return attrs
+}
+
+// checkToolNames rejects definitions where two subcommands map to the same tool.
+func checkToolNames(def CommandDefinition) error {
+	seen := make(map[string]bool)
+	for _, sub := range def.Subcommands {
+		name := toolName(def.Command, sub)
+		if seen[name] {
+			return fmt.Errorf("duplicate tool name %q", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// checkParameters rejects parameter attributes the generator cannot honor.
+func checkParameters(def CommandDefinition) error {
+	for _, sub := range def.Subcommands {
+		for _, param := range sub.Parameters {
+			if param.Nullable && param.Type != typeBoolean && param.Type != typeInteger {
+				return fmt.Errorf("subcommand %q: parameter %q: nullable requires boolean or integer type", sub.Name, param.Name)
+			}
+			if param.Stdin && (param.Type != typeString || param.Positional) {
+				return fmt.Errorf("subcommand %q: parameter %q: stdin requires a non-positional string", sub.Name, param.Name)
+			}
+			if param.Separator != "" && (param.Type != "map" || (param.Separator != "=" && param.Separator != ":")) {
+				return fmt.Errorf("subcommand %q: parameter %q: separator must be = or : on a map", sub.Name, param.Name)
+			}
+			if param.ReadOnlyValue != "" {
+				if param.Type != typeString || param.Positional || param.Stdin {
+					return fmt.Errorf("subcommand %q: parameter %q: read_only_value requires a string flag", sub.Name, param.Name)
+				}
+				if len(param.Enum) > 0 && !slices.Contains(param.Enum, param.ReadOnlyValue) {
+					return fmt.Errorf("subcommand %q: parameter %q: read_only_value %q is not one of its enum values", sub.Name, param.Name, param.ReadOnlyValue)
+				}
+			}
+		}
+		if len(stdinArgs(sub.Parameters)) > 1 {
+			return fmt.Errorf("subcommand %q: only one parameter can read from stdin", sub.Name)
+		}
+	}
+	return nil
+}
+
+// jsonFieldTypes are the JSON schema types a json_output field may declare.
+var jsonFieldTypes = map[string]bool{
+	"string":  true,
+	"integer": true,
+	"number":  true,
+	"boolean": true,
+	"object":  true,
+	"array":   true,
+}
+
+// checkJSONOutput rejects json_output blocks on subcommands without a --json
+// array parameter, and fields with an unknown or duplicate name or type. The
+// text field is reserved for output that is not JSON.
+func checkJSONOutput(def CommandDefinition) error {
+	for _, sub := range def.Subcommands {
+		if sub.JSONOutput == nil {
+			continue
+		}
+
+		param, ok := findParameter(sub, "json")
+		if !ok || param.Type != "array" {
+			return fmt.Errorf("subcommand %q: json_output requires a json array parameter", sub.Name)
+		}
+
+		seen := make(map[string]bool)
+		for _, field := range sub.JSONOutput.Fields {
+			if seen[field.Name] {
+				return fmt.Errorf("subcommand %q: duplicate json_output field %q", sub.Name, field.Name)
+			}
+			if field.Name == "text" && !sub.JSONOutput.Array {
+				return fmt.Errorf("subcommand %q: json_output field %q is reserved for text output", sub.Name, field.Name)
+			}
+			seen[field.Name] = true
+			if !jsonFieldTypes[field.Type] {
+				return fmt.Errorf("subcommand %q: json_output field %q: unknown type %q", sub.Name, field.Name, field.Type)
+			}
+		}
+	}
+	return nil
+}
+
+// formatParameter is the synthetic argument added to subcommands with
+// default_json_fields, letting callers ask for gh's text output instead.
+var formatParameter = Parameter{
+	Name:        "format",
+	Type:        typeString,
+	Description: "Output format: json returns structured data, text returns gh's human-readable output",
+	Enum:        []string{"json", "text"},
+	Default:     "json",
+	Synthetic:   true,
+}
+
+// addFormatParameters validates default_json_fields and adds the synthetic
+// format parameter to the subcommands that declare them.
+func addFormatParameters(def CommandDefinition) error {
+	for i := range def.Subcommands {
+		sub := &def.Subcommands[i]
+		if len(sub.DefaultJSONFields) == 0 {
+			continue
+		}
+
+		if sub.JSONOutput == nil {
+			return fmt.Errorf("subcommand %q: default_json_fields requires json_output", sub.Name)
+		}
+		if hasParam(*sub, formatParameter.Name) {
+			return fmt.Errorf("subcommand %q: default_json_fields conflicts with parameter %q", sub.Name, formatParameter.Name)
+		}
+
+		fields := make(map[string]bool)
+		for _, field := range sub.JSONOutput.Fields {
+			fields[field.Name] = true
+		}
+		for _, name := range sub.DefaultJSONFields {
+			if !fields[name] {
+				return fmt.Errorf("subcommand %q: default json field %q is not declared in json_output", sub.Name, name)
+			}
+		}
+
+		for j := range sub.Parameters {
+			if sub.Parameters[j].Name == "json" {
+				sub.Parameters[j].Description += fmt.Sprintf(" (default: %s)", strings.Join(sub.DefaultJSONFields, ","))
+			}
+		}
+		sub.Parameters = append(sub.Parameters, formatParameter)
+	}
+	return nil
+}
+
+// checkTimeouts validates subcommand timeouts.
+func checkTimeouts(def CommandDefinition) error {
+	for _, sub := range def.Subcommands {
+		if sub.Timeout != "" {
+			timeout, err := time.ParseDuration(sub.Timeout)
+			if err != nil {
+				return fmt.Errorf("subcommand %q: invalid timeout: %w", sub.Name, err)
+			}
+			if timeout < time.Second || timeout%time.Second != 0 {
+				return fmt.Errorf("subcommand %q: timeout %q must be a positive whole number of seconds", sub.Name, sub.Timeout)
+			}
+		}
+	}
+	return nil
+}
+
+// Subcommand access levels.
+const (
+	accessRead        = "read"
+	accessWrite       = "write"
+	accessDestructive = "destructive"
+)
+
+// checkAccess validates subcommand access levels, defaulting them to write,
+// and rejects idempotent on read subcommands, which are idempotent anyway.
+func checkAccess(def CommandDefinition) error {
+	for i := range def.Subcommands {
+		sub := &def.Subcommands[i]
+		switch sub.Access {
+		case "":
+			sub.Access = accessWrite
+		case accessRead, accessWrite, accessDestructive:
+		default:
+			return fmt.Errorf("subcommand %q: access %q must be read, write or destructive", sub.Name, sub.Access)
+		}
+		if sub.Idempotent && sub.Access == accessRead {
+			return fmt.Errorf("subcommand %q: idempotent only applies to write or destructive access", sub.Name)
+		}
+		if sub.Additive && sub.Access != accessWrite {
+			return fmt.Errorf("subcommand %q: additive only applies to write access", sub.Name)
+		}
+		if sub.Additive && len(readOnlyArgs(sub.Parameters)) > 0 {
+			return fmt.Errorf("subcommand %q: additive cannot apply to a subcommand whose arguments choose what it does (read_only_value)", sub.Name)
+		}
+	}
+	return nil
+}
+
+// checkConfirmUnless validates confirm_unless: it only applies to write
+// subcommands, since destructive ones always confirm and read ones never
+// do, and must name string parameters with at least one value.
+func checkConfirmUnless(def CommandDefinition) error {
+	for _, sub := range def.Subcommands {
+		if len(sub.ConfirmUnless) == 0 {
+			continue
+		}
+		if sub.Access != accessWrite {
+			return fmt.Errorf("subcommand %q: confirm_unless only applies to write access", sub.Name)
+		}
+		for _, name := range slices.Sorted(maps.Keys(sub.ConfirmUnless)) {
+			if !hasStringParam(sub, name) {
+				return fmt.Errorf("subcommand %q: confirm_unless names %q, which is not a string parameter", sub.Name, name)
+			}
+			if len(sub.ConfirmUnless[name]) == 0 {
+				return fmt.Errorf("subcommand %q: confirm_unless lists no values for %q", sub.Name, name)
+			}
+		}
+	}
+	return nil
+}
+
+// reservedParameters are arguments every generated tool accepts.
+var reservedParameters = []string{"timeout_seconds", "cwd", "confirm_token"}
+
+// checkReservedParameters rejects parameters that clash with the arguments
+// every tool accepts.
+func checkReservedParameters(def CommandDefinition) error {
+	for _, sub := range def.Subcommands {
+		for _, name := range reservedParameters {
+			if hasParam(sub, name) {
+				return fmt.Errorf("subcommand %q: parameter %q is reserved", sub.Name, name)
+			}
+		}
+	}
+	return nil
+}
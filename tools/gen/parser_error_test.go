@@ -164,6 +164,564 @@ subcommands:
 		assert.Equal(t, "", def.Command)
 		assert.Empty(t, def.Subcommands)
 	})
+
+	t.Run("subcommand group with parameters", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		groupFile := filepath.Join(tmpDir, "group.yaml")
+
+		groupYAML := `
+command: repo
+subcommands:
+  - name: deploy-key
+    parameters:
+      - name: repo
+        type: string
+        flag: --repo
+    subcommands:
+      - name: list
+        description: List deploy keys
+`
+		err := os.WriteFile(groupFile, []byte(groupYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(groupFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `subcommand group "deploy-key" cannot declare parameters`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("subcommand group sharing a leaf's name", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		groupFile := filepath.Join(tmpDir, "group.yaml")
+
+		groupYAML := `
+command: codespace
+subcommands:
+  - name: ports
+    description: List ports
+  - name: ports
+    subcommands:
+      - name: visibility
+        description: Change port visibility
+`
+		err := os.WriteFile(groupFile, []byte(groupYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(groupFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `subcommand "ports" is declared more than once`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("subcommand group with leaf attributes", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		groupFile := filepath.Join(tmpDir, "group.yaml")
+
+		groupYAML := `
+command: repo
+subcommands:
+  - name: deploy-key
+    access: destructive
+    timeout: 30s
+    open_world: false
+    subcommands:
+      - name: delete
+        description: Delete a deploy key
+`
+		err := os.WriteFile(groupFile, []byte(groupYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(groupFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `subcommand group "deploy-key" cannot declare access, open_world, timeout`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("nullable parameter with unsupported type", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		nullableFile := filepath.Join(tmpDir, "nullable.yaml")
+
+		nullableYAML := `
+command: repo
+subcommands:
+  - name: edit
+    parameters:
+      - name: description
+        type: string
+        flag: --description
+        nullable: true
+`
+		err := os.WriteFile(nullableFile, []byte(nullableYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(nullableFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `parameter "description": nullable requires boolean or integer type`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("stdin parameter with unsupported type", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		stdinFile := filepath.Join(tmpDir, "stdin.yaml")
+
+		stdinYAML := `
+command: secret
+subcommands:
+  - name: set
+    parameters:
+      - name: body
+        type: array
+        stdin: true
+`
+		err := os.WriteFile(stdinFile, []byte(stdinYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(stdinFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `parameter "body": stdin requires a non-positional string`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("multiple stdin parameters", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		stdinFile := filepath.Join(tmpDir, "stdin.yaml")
+
+		stdinYAML := `
+command: secret
+subcommands:
+  - name: set
+    parameters:
+      - name: body
+        type: string
+        stdin: true
+      - name: token
+        type: string
+        stdin: true
+`
+		err := os.WriteFile(stdinFile, []byte(stdinYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(stdinFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `subcommand "set": only one parameter can read from stdin`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("duplicate tool names", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		duplicateFile := filepath.Join(tmpDir, "duplicate.yaml")
+
+		duplicateYAML := `
+command: repo
+subcommands:
+  - name: deploy-key-list
+    description: Flat spelling
+  - name: deploy-key
+    subcommands:
+      - name: list
+        description: Nested spelling
+`
+		err := os.WriteFile(duplicateFile, []byte(duplicateYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(duplicateFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `duplicate tool name "gh_repo_deploy_key_list"`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("json output without json parameter", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		outputFile := filepath.Join(tmpDir, "output.yaml")
+
+		outputYAML := `
+command: label
+subcommands:
+  - name: list
+    parameters:
+      - name: limit
+        type: integer
+        flag: --limit
+    json_output:
+      array: true
+      fields:
+        - {name: name, type: string}
+`
+		err := os.WriteFile(outputFile, []byte(outputYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(outputFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `subcommand "list": json_output requires a json array parameter`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("json output field with unknown type", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		outputFile := filepath.Join(tmpDir, "output.yaml")
+
+		outputYAML := `
+command: label
+subcommands:
+  - name: list
+    parameters:
+      - name: json
+        type: array
+        flag: --json
+    json_output:
+      fields:
+        - {name: color, type: colour}
+`
+		err := os.WriteFile(outputFile, []byte(outputYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(outputFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `json_output field "color": unknown type "colour"`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("json output field reserved for text output", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		outputFile := filepath.Join(tmpDir, "output.yaml")
+
+		outputYAML := `
+command: gist
+subcommands:
+  - name: view
+    parameters:
+      - name: json
+        type: array
+        flag: --json
+    json_output:
+      fields:
+        - {name: text, type: string}
+`
+		err := os.WriteFile(outputFile, []byte(outputYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(outputFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `json_output field "text" is reserved for text output`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("default json field not in json output", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		outputFile := filepath.Join(tmpDir, "output.yaml")
+
+		outputYAML := `
+command: label
+subcommands:
+  - name: list
+    parameters:
+      - name: json
+        type: array
+        flag: --json
+    default_json_fields: [name, colour]
+    json_output:
+      fields:
+        - {name: name, type: string}
+        - {name: color, type: string}
+`
+		err := os.WriteFile(outputFile, []byte(outputYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(outputFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `default json field "colour" is not declared in json_output`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("default json fields without json output", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		outputFile := filepath.Join(tmpDir, "output.yaml")
+
+		outputYAML := `
+command: label
+subcommands:
+  - name: list
+    parameters:
+      - name: json
+        type: array
+        flag: --json
+    default_json_fields: [name]
+`
+		err := os.WriteFile(outputFile, []byte(outputYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(outputFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `subcommand "list": default_json_fields requires json_output`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("default json fields with format parameter", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		outputFile := filepath.Join(tmpDir, "output.yaml")
+
+		outputYAML := `
+command: project
+subcommands:
+  - name: list
+    parameters:
+      - name: json
+        type: array
+        flag: --json
+      - name: format
+        type: string
+        flag: --format
+    default_json_fields: [title]
+    json_output:
+      fields:
+        - {name: title, type: string}
+`
+		err := os.WriteFile(outputFile, []byte(outputYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(outputFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `default_json_fields conflicts with parameter "format"`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("invalid timeout", func(t *testing.T) {
+		tests := map[string]string{
+			"soon":  `subcommand "watch": invalid timeout`,
+			"500ms": `subcommand "watch": timeout "500ms" must be a positive whole number of seconds`,
+			"-1m":   `subcommand "watch": timeout "-1m" must be a positive whole number of seconds`,
+		}
+
+		for timeout, wantErr := range tests {
+			tmpDir := t.TempDir()
+			timeoutFile := filepath.Join(tmpDir, "timeout.yaml")
+
+			timeoutYAML := `
+command: run
+subcommands:
+  - name: watch
+    timeout: "` + timeout + `"
+`
+			err := os.WriteFile(timeoutFile, []byte(timeoutYAML), 0644)
+			require.NoError(t, err)
+
+			def, err := parseDefinitionFile(timeoutFile)
+			assert.Error(t, err, timeout)
+			assert.Contains(t, err.Error(), wantErr)
+			assert.Equal(t, CommandDefinition{}, def)
+		}
+	})
+
+	t.Run("reserved timeout_seconds parameter", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		reservedFile := filepath.Join(tmpDir, "reserved.yaml")
+
+		reservedYAML := `
+command: run
+subcommands:
+  - name: watch
+    parameters:
+      - name: timeout_seconds
+        type: integer
+        flag: --timeout
+`
+		err := os.WriteFile(reservedFile, []byte(reservedYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(reservedFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `subcommand "watch": parameter "timeout_seconds" is reserved`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("invalid access", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		accessFile := filepath.Join(tmpDir, "access.yaml")
+
+		accessYAML := `
+command: repo
+subcommands:
+  - name: delete
+    access: dangerous
+`
+		err := os.WriteFile(accessFile, []byte(accessYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(accessFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `subcommand "delete": access "dangerous" must be read, write or destructive`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("invalid read_only_value", func(t *testing.T) {
+		tests := map[string]string{
+			`
+      - name: paginate
+        type: boolean
+        flag: --paginate
+        read_only_value: "true"`: `parameter "paginate": read_only_value requires a string flag`,
+			`
+      - name: method
+        type: string
+        flag: --method
+        enum: [GET, POST]
+        read_only_value: HEAD`: `parameter "method": read_only_value "HEAD" is not one of its enum values`,
+		}
+
+		for params, wantErr := range tests {
+			tmpDir := t.TempDir()
+			readOnlyFile := filepath.Join(tmpDir, "api.yaml")
+
+			readOnlyYAML := `
+command: api
+subcommands:
+  - name: request
+    parameters:` + params + "\n"
+			err := os.WriteFile(readOnlyFile, []byte(readOnlyYAML), 0644)
+			require.NoError(t, err)
+
+			def, err := parseDefinitionFile(readOnlyFile)
+			assert.Error(t, err, wantErr)
+			assert.Contains(t, err.Error(), wantErr)
+			assert.Equal(t, CommandDefinition{}, def)
+		}
+	})
+
+	t.Run("invalid separator", func(t *testing.T) {
+		tests := map[string]string{
+			`
+      - name: method
+        type: string
+        flag: --method
+        separator: ":"`: `parameter "method": separator must be = or : on a map`,
+			`
+      - name: header
+        type: map
+        flag: -H
+        separator: ";"`: `parameter "header": separator must be = or : on a map`,
+		}
+
+		for params, wantErr := range tests {
+			tmpDir := t.TempDir()
+			separatorFile := filepath.Join(tmpDir, "api.yaml")
+
+			separatorYAML := `
+command: api
+subcommands:
+  - name: request
+    parameters:` + params + "\n"
+			err := os.WriteFile(separatorFile, []byte(separatorYAML), 0644)
+			require.NoError(t, err)
+
+			def, err := parseDefinitionFile(separatorFile)
+			assert.Error(t, err, wantErr)
+			assert.Contains(t, err.Error(), wantErr)
+			assert.Equal(t, CommandDefinition{}, def)
+		}
+	})
+
+	t.Run("reserved cwd parameter", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		reservedFile := filepath.Join(tmpDir, "reserved.yaml")
+
+		reservedYAML := `
+command: repo
+subcommands:
+  - name: clone
+    parameters:
+      - name: cwd
+        type: string
+        positional: true
+`
+		err := os.WriteFile(reservedFile, []byte(reservedYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(reservedFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `subcommand "clone": parameter "cwd" is reserved`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("reserved confirm_token parameter", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		reservedFile := filepath.Join(tmpDir, "reserved.yaml")
+
+		reservedYAML := `
+command: repo
+subcommands:
+  - name: delete
+    access: destructive
+    parameters:
+      - name: confirm_token
+        type: string
+        flag: --confirm-token
+`
+		err := os.WriteFile(reservedFile, []byte(reservedYAML), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(reservedFile)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `subcommand "delete": parameter "confirm_token" is reserved`)
+		assert.Equal(t, CommandDefinition{}, def)
+	})
+
+	t.Run("invalid confirm_unless", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			yaml    string
+			wantErr string
+		}{
+			{
+				name: "read access",
+				yaml: `
+command: api
+subcommands:
+  - name: request
+    access: read
+    confirm_unless: {method: [GET]}
+    parameters:
+      - {name: method, type: string, flag: --method}
+`,
+				wantErr: `subcommand "request": confirm_unless only applies to write access`,
+			},
+			{
+				name: "unknown parameter",
+				yaml: `
+command: api
+subcommands:
+  - name: request
+    confirm_unless: {verb: [GET]}
+    parameters:
+      - {name: method, type: string, flag: --method}
+`,
+				wantErr: `subcommand "request": confirm_unless names "verb", which is not a string parameter`,
+			},
+			{
+				name: "no values",
+				yaml: `
+command: api
+subcommands:
+  - name: request
+    confirm_unless: {method: []}
+    parameters:
+      - {name: method, type: string, flag: --method}
+`,
+				wantErr: `subcommand "request": confirm_unless lists no values for "method"`,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				file := filepath.Join(t.TempDir(), "api.yaml")
+				require.NoError(t, os.WriteFile(file, []byte(tt.yaml), 0644))
+
+				def, err := parseDefinitionFile(file)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Equal(t, CommandDefinition{}, def)
+			})
+		}
+	})
 }
 
 func TestParseDefinitions_MultipleFiles(t *testing.T) {
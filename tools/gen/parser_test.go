@@ -3,6 +3,7 @@ package main
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -241,6 +242,219 @@ subcommands:
 		assert.Len(t, def.Subcommands[0].Parameters[0].Enum, 4)
 		assert.Equal(t, []string{"debug", "info", "warn", "error"}, def.Subcommands[0].Parameters[0].Enum)
 	})
+
+	t.Run("flattens nested subcommand groups", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		yamlContent := `command: repo
+description: Repository commands
+subcommands:
+  - name: view
+    description: View a repository
+  - name: deploy-key
+    description: Manage deploy keys
+    subcommands:
+      - name: list
+        description: List deploy keys
+      - name: add
+        description: Add a deploy key
+        parameters:
+          - name: key_file
+            type: string
+            positional: true
+  - name: ports
+    description: Nested group two levels deep
+    subcommands:
+      - name: rules
+        description: Manage rules
+        subcommands:
+          - name: delete
+            description: Delete a rule
+`
+		filePath := filepath.Join(tmpDir, "repo.yaml")
+		err := os.WriteFile(filePath, []byte(yamlContent), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(filePath)
+		require.NoError(t, err)
+		require.Len(t, def.Subcommands, 4, "groups should be replaced by their leaves")
+
+		assert.Equal(t, "view", def.Subcommands[0].Name)
+		assert.Empty(t, def.Subcommands[0].Argv, "top-level leaves keep the default argv")
+
+		assert.Equal(t, "deploy-key-list", def.Subcommands[1].Name)
+		assert.Equal(t, []string{"repo", "deploy-key", "list"}, def.Subcommands[1].Argv)
+		assert.Equal(t, "gh_repo_deploy_key_list", toolName(def.Command, def.Subcommands[1]))
+
+		assert.Equal(t, "deploy-key-add", def.Subcommands[2].Name)
+		assert.Equal(t, []string{"repo", "deploy-key", "add"}, def.Subcommands[2].Argv)
+		assert.Len(t, def.Subcommands[2].Parameters, 1)
+
+		assert.Equal(t, "ports-rules-delete", def.Subcommands[3].Name)
+		assert.Equal(t, []string{"repo", "ports", "rules", "delete"}, def.Subcommands[3].Argv)
+	})
+
+	t.Run("nested leaves inherit an explicit group argv", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		yamlContent := `command: auth
+description: Auth commands
+subcommands:
+  - name: setup_git
+    description: Group with a gh name that differs from the tool name
+    argv: [auth, setup-git]
+    subcommands:
+      - name: check
+        description: Check setup
+`
+		filePath := filepath.Join(tmpDir, "auth.yaml")
+		err := os.WriteFile(filePath, []byte(yamlContent), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(filePath)
+		require.NoError(t, err)
+		require.Len(t, def.Subcommands, 1)
+		assert.Equal(t, "setup_git-check", def.Subcommands[0].Name)
+		assert.Equal(t, []string{"auth", "setup-git", "check"}, def.Subcommands[0].Argv)
+		assert.Equal(t, "gh_auth_setup_git_check", toolName(def.Command, def.Subcommands[0]))
+	})
+
+	t.Run("adds format parameter for default json fields", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		yamlContent := `command: label
+description: Label commands
+subcommands:
+  - name: list
+    description: List labels
+    parameters:
+      - name: json
+        type: array
+        flag: --json
+        description: Output JSON with the specified fields
+    default_json_fields: [name, color]
+    json_output:
+      array: true
+      fields:
+        - {name: name, type: string}
+        - {name: color, type: string}
+        - {name: url, type: string}
+`
+		filePath := filepath.Join(tmpDir, "label.yaml")
+		err := os.WriteFile(filePath, []byte(yamlContent), 0644)
+		require.NoError(t, err)
+
+		def, err := parseDefinitionFile(filePath)
+		require.NoError(t, err)
+		require.Len(t, def.Subcommands, 1)
+
+		sub := def.Subcommands[0]
+		assert.Equal(t, []string{"name", "color"}, sub.DefaultJSONFields)
+		require.Len(t, sub.Parameters, 2)
+		assert.Equal(t, "Output JSON with the specified fields (default: name,color)", sub.Parameters[0].Description)
+		assert.Equal(t, formatParameter, sub.Parameters[1])
+	})
+}
+
+func TestParseDefinitionFile_Access(t *testing.T) {
+	tmpDir := t.TempDir()
+	yamlContent := `command: repo
+subcommands:
+  - name: view
+    access: read
+  - name: delete
+    access: destructive
+  - name: sync
+`
+	filePath := filepath.Join(tmpDir, "repo.yaml")
+	require.NoError(t, os.WriteFile(filePath, []byte(yamlContent), 0644))
+
+	def, err := parseDefinitionFile(filePath)
+	require.NoError(t, err)
+
+	require.Len(t, def.Subcommands, 3)
+	assert.Equal(t, accessRead, def.Subcommands[0].Access)
+	assert.Equal(t, accessDestructive, def.Subcommands[1].Access)
+	assert.Equal(t, accessWrite, def.Subcommands[2].Access, "access defaults to write")
+}
+
+func TestParseDefinitionFile_Annotations(t *testing.T) {
+	t.Run("parses titles and hints", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		yamlContent := `command: alias
+subcommands:
+  - name: list
+    title: List Aliases
+    access: read
+    open_world: false
+  - name: delete
+    access: destructive
+    idempotent: true
+`
+		filePath := filepath.Join(tmpDir, "alias.yaml")
+		require.NoError(t, os.WriteFile(filePath, []byte(yamlContent), 0644))
+
+		def, err := parseDefinitionFile(filePath)
+		require.NoError(t, err)
+
+		require.Len(t, def.Subcommands, 2)
+		assert.Equal(t, "List Aliases", def.Subcommands[0].Title)
+		assert.False(t, def.Subcommands[0].Idempotent)
+		require.NotNil(t, def.Subcommands[0].OpenWorld)
+		assert.False(t, *def.Subcommands[0].OpenWorld)
+		assert.True(t, def.Subcommands[1].Idempotent)
+		assert.Nil(t, def.Subcommands[1].OpenWorld, "open_world is unset unless declared")
+	})
+
+	t.Run("rejects idempotent read subcommands", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		yamlContent := `command: alias
+subcommands:
+  - name: list
+    access: read
+    idempotent: true
+`
+		filePath := filepath.Join(tmpDir, "alias.yaml")
+		require.NoError(t, os.WriteFile(filePath, []byte(yamlContent), 0644))
+
+		_, err := parseDefinitionFile(filePath)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `subcommand "list": idempotent only applies to write or destructive access`)
+	})
+
+	t.Run("rejects additive subcommands that are not writes", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		yamlContent := `command: repo
+subcommands:
+  - name: delete
+    access: destructive
+    additive: true
+`
+		filePath := filepath.Join(tmpDir, "repo.yaml")
+		require.NoError(t, os.WriteFile(filePath, []byte(yamlContent), 0644))
+
+		_, err := parseDefinitionFile(filePath)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `subcommand "delete": additive only applies to write access`)
+	})
+
+	t.Run("rejects additive subcommands with read-only arguments", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		yamlContent := `command: api
+subcommands:
+  - name: request
+    access: write
+    additive: true
+    parameters:
+      - name: method
+        type: string
+        flag: --method
+        read_only_value: GET
+`
+		filePath := filepath.Join(tmpDir, "api.yaml")
+		require.NoError(t, os.WriteFile(filePath, []byte(yamlContent), 0644))
+
+		_, err := parseDefinitionFile(filePath)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `subcommand "request": additive cannot apply`)
+	})
 }
 
 func TestParseDefinitions_RealData(t *testing.T) {
@@ -276,4 +490,38 @@ func TestParseDefinitions_RealData(t *testing.T) {
 		}
 		assert.GreaterOrEqual(t, totalSubcommands, 150, "should have at least 150 total subcommands")
 	})
+
+	t.Run("deleting subcommands are destructive", func(t *testing.T) {
+		definitionsDir := "../../internal/commands/definitions"
+		if _, err := os.Stat(definitionsDir); os.IsNotExist(err) {
+			t.Skip("Definitions directory not found")
+		}
+
+		definitions, err := ParseDefinitions(definitionsDir)
+		require.NoError(t, err)
+
+		for _, def := range definitions {
+			for _, sub := range def.Subcommands {
+				if strings.HasSuffix(sub.Name, "delete") || strings.HasSuffix(sub.Name, "remove") {
+					assert.Equal(t, accessDestructive, sub.Access, "gh %s %s", def.Command, sub.Name)
+				}
+			}
+		}
+	})
+
+	t.Run("every subcommand has a title", func(t *testing.T) {
+		definitionsDir := "../../internal/commands/definitions"
+		if _, err := os.Stat(definitionsDir); os.IsNotExist(err) {
+			t.Skip("Definitions directory not found")
+		}
+
+		definitions, err := ParseDefinitions(definitionsDir)
+		require.NoError(t, err)
+
+		for _, def := range definitions {
+			for _, sub := range def.Subcommands {
+				assert.NotEmpty(t, sub.Title, "gh %s %s", def.Command, sub.Name)
+			}
+		}
+	})
 }
@@ -4,14 +4,25 @@ const commandTemplate = `// Code generated by tools/gen. DO NOT EDIT.
 package generated
 
 import (
+	"cmp"
 	"context"
+	{{if needsFmt . -}}
 	"fmt"
+	{{end -}}
+	{{if needsMaps . -}}
+	"maps"
+	{{end -}}
+	{{if needsSlices . -}}
+	"slices"
+	{{end -}}
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/session"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolkit"
 )
 
 {{range .Subcommands}}
-// {{toTitle $.Command}}{{toTitle .Name}}Args defines parameters for gh {{$.Command}} {{.Name}}
+// {{toTitle $.Command}}{{toTitle .Name}}Args defines parameters for gh {{argvString $.Command .}}
 type {{toTitle $.Command}}{{toTitle .Name}}Args struct {
 	{{range nonPositional .Parameters -}}
 	{{toTitle .Name}} {{goType .}} ` + "`" + `{{jsonTag .}} {{schemaTag .}}` + "`" + `
@@ -19,15 +30,104 @@ type {{toTitle $.Command}}{{toTitle .Name}}Args struct {
 	{{range positionalArgs .Parameters -}}
 	{{toTitle .Name}} {{goType .}} ` + "`" + `{{jsonTag .}} {{schemaTag .}}` + "`" + `
 	{{end}}
+	TimeoutSeconds int ` + "`" + `json:"timeout_seconds,omitempty" jsonschema:"Override the command timeout in seconds (capped by the server maximum)"` + "`" + `
+	Cwd string ` + "`" + `json:"cwd,omitempty" jsonschema:"Absolute path of the directory to run gh in, such as a local checkout of the repository (must be within the client's roots)"` + "`" + `
+	{{- if confirms .}}
+	ConfirmToken string ` + "`" + `json:"confirm_token,omitempty" jsonschema:"Token from a confirmation_required result, passed once the user has approved the command"` + "`" + `
+	{{- end}}
 }
 
-// Register{{toTitle $.Command}}{{toTitle .Name}}Tool registers the gh {{$.Command}} {{.Name}} tool
-func Register{{toTitle $.Command}}{{toTitle .Name}}Tool(server *mcp.Server, exec *executor.Executor) {
+// {{toCamel $.Command}}{{toTitle .Name}}InputSpec holds the argument constraints for gh {{argvString $.Command .}}
+var {{toCamel $.Command}}{{toTitle .Name}}InputSpec = toolkit.InputSpec{
+	{{with requiredArgs .Parameters -}}
+	Required: []string{ {{- range $i, $p := .}}{{if $i}}, {{end}}"{{toSnake $p.Name}}"{{end -}} },
+	{{end -}}
+	{{with constrainedArgs .Parameters -}}
+	Properties: map[string]toolkit.Property{
+		{{range . -}}
+		"{{toSnake .Name}}": {
+			{{- with .Enum}}Enum: []string{ {{- range $i, $v := .}}{{if $i}}, {{end}}{{printf "%q" $v}}{{end -}} },{{end -}}
+			{{- with .Default}} Default: {{goLiteral .}},{{end -}}
+		},
+		{{end -}}
+	},
+	{{end -}}
+}
+
+{{if .JSONOutput -}}
+// {{toCamel $.Command}}{{toTitle .Name}}OutputSpec describes the --json output of gh {{argvString $.Command .}}
+var {{toCamel $.Command}}{{toTitle .Name}}OutputSpec = toolkit.OutputSpec{
+	{{if .JSONOutput.Array -}}
+	Array: true,
+	{{end -}}
+	Fields: []toolkit.OutputField{
+		{{range .JSONOutput.Fields -}}
+		{Name: "{{.Name}}", Type: "{{.Type}}", Description: {{printf "%q" .Description}}{{if .Nullable}}, Nullable: true{{end}}},
+		{{end -}}
+	},
+}
+
+{{end -}}
+{{if .DefaultJSONFields -}}
+// {{toCamel $.Command}}{{toTitle .Name}}DefaultJSONFields are requested when no json fields are given
+var {{toCamel $.Command}}{{toTitle .Name}}DefaultJSONFields = []string{ {{- range $i, $f := .DefaultJSONFields}}{{if $i}}, {{end}}"{{$f}}"{{end -}} }
+
+{{end -}}
+// Register{{toTitle $.Command}}{{toTitle .Name}}Tool registers the gh {{argvString $.Command .}} tool
+func Register{{toTitle $.Command}}{{toTitle .Name}}Tool(server *mcp.Server, exec executor.Runner) {
 	mcp.AddTool(server, &mcp.Tool{
-		Name: "gh_{{$.Command}}_{{toSnake .Name}}",
+		Name: "{{toolName $.Command .}}",
 		Description: "{{.Description}}",
+		InputSchema: toolkit.InputSchema[{{toTitle $.Command}}{{toTitle .Name}}Args]({{toCamel $.Command}}{{toTitle .Name}}InputSpec),
+		{{- if .JSONOutput}}
+		OutputSchema: toolkit.OutputSchema({{toCamel $.Command}}{{toTitle .Name}}OutputSpec),
+		{{- end}}
+		Annotations: &mcp.ToolAnnotations{
+			Title: {{printf "%q" (toolTitle .)}},
+			ReadOnlyHint: {{eq .Access "read"}},
+			DestructiveHint: toolkit.Bool({{destructiveHint .}}),
+			IdempotentHint: {{.Idempotent}},
+			OpenWorldHint: toolkit.Bool({{openWorld .}}),
+		},
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args {{toTitle $.Command}}{{toTitle .Name}}Args) (*mcp.CallToolResult, any, error) {
-		cmd := []string{"{{$.Command}}", "{{.Name}}"}
+		{{- if requiredArgs .Parameters}}
+		if err := {{toCamel $.Command}}{{toTitle .Name}}InputSpec.Validate(args); err != nil {
+			return toolkit.ValidationErrorResult("gh {{argvString $.Command .}}", err), nil, nil
+		}
+		{{- end}}
+
+		defaults := session.For(req).Without(session.Defaults{
+			{{- if hasStringParam . "repo"}}Repo: args.Repo, {{end -}}
+			{{- if hasStringParam . "hostname"}}Hostname: args.Hostname, {{end -}}
+			Cwd: args.Cwd})
+		dir, err := toolkit.WorkingDir(ctx, req, cmp.Or(args.Cwd, defaults.Cwd))
+		if err != nil {
+			return defaults.Annotate(toolkit.ValidationErrorResult("gh {{argvString $.Command .}}", err)), nil, nil
+		}
+		creds, err := session.Credentials(req)
+		if err != nil {
+			return defaults.Annotate(toolkit.ErrorResult("gh {{argvString $.Command .}}", nil, err)), nil, nil
+		}
+		{{- $sub := .}}
+		{{- with readOnlyArgs .Parameters}}
+
+		if exec.ReadOnly() {
+			{{- range .}}
+			if args.{{toTitle .Name}}, err = toolkit.ReadOnlyArg("{{toSnake .Name}}", args.{{toTitle .Name}}, {{printf "%q" .ReadOnlyValue}}); err != nil {
+				return defaults.Annotate(toolkit.ValidationErrorResult("gh {{argvString $.Command $sub}}", err)), nil, nil
+			}
+			{{- end}}
+		}
+		{{- end}}
+
+		{{- if .DefaultJSONFields}}
+
+		if len(args.Json) == 0 && args.Format != "text"{{if hasParam . "web"}} && !args.Web{{end}}{{if hasBoolParam . "comments"}} && !args.Comments{{end}} {
+			args.Json = {{toCamel $.Command}}{{toTitle .Name}}DefaultJSONFields
+		}
+		{{- end}}
+
+		cmd := []string{ {{- argvLiteral $.Command . -}} }
 
 		{{range positionalArgs .Parameters -}}
 		// Add positional argument: {{.Name}}
@@ -45,10 +145,25 @@ func Register{{toTitle $.Command}}{{toTitle .Name}}Tool(server *mcp.Server, exec
 		{{end}}
 
 		{{range nonPositional .Parameters -}}
-		{{if eq .Type "boolean" -}}
+		{{if .Synthetic -}}
+		{{else if .Stdin -}}
+		{{if .Flag -}}
+		if args.{{toTitle .Name}} != "" {
+			cmd = append(cmd, "{{.Flag}}")
+		}
+		{{end -}}
+		{{else if and (eq .Type "boolean") .Nullable -}}
+		if args.{{toTitle .Name}} != nil {
+			cmd = append(cmd, fmt.Sprintf("{{.Flag}}=%t", *args.{{toTitle .Name}}))
+		}
+		{{else if eq .Type "boolean" -}}
 		if args.{{toTitle .Name}} {
 			cmd = append(cmd, "{{.Flag}}")
 		}
+		{{else if and (eq .Type "integer") .Nullable -}}
+		if args.{{toTitle .Name}} != nil {
+			cmd = append(cmd, "{{.Flag}}", fmt.Sprintf("%d", *args.{{toTitle .Name}}))
+		}
 		{{else if eq .Type "integer" -}}
 		if args.{{toTitle .Name}} > 0 {
 			cmd = append(cmd, "{{.Flag}}", fmt.Sprintf("%d", args.{{toTitle .Name}}))
@@ -58,8 +173,8 @@ func Register{{toTitle $.Command}}{{toTitle .Name}}Tool(server *mcp.Server, exec
 			cmd = append(cmd, "{{.Flag}}", v)
 		}
 		{{else if eq .Type "map" -}}
-		for k, v := range args.{{toTitle .Name}} {
-			cmd = append(cmd, "{{.Flag}}", fmt.Sprintf("%s=%s", k, v))
+		for _, k := range slices.Sorted(maps.Keys(args.{{toTitle .Name}})) {
+			cmd = append(cmd, "{{.Flag}}", fmt.Sprintf("%s{{separator .}}%s", k, args.{{toTitle .Name}}[k]))
 		}
 		{{else -}}
 		if args.{{toTitle .Name}} != "" {
@@ -68,20 +183,59 @@ func Register{{toTitle $.Command}}{{toTitle .Name}}Tool(server *mcp.Server, exec
 		{{end}}
 		{{end}}
 
-		result, err := exec.Execute(ctx, cmd...)
+		executor.Attempt(ctx, cmd, cmp.Or(dir, exec.Workdir()))
+		{{- if confirms .}}
+
+		if exec.ConfirmDestructive(){{with .ConfirmUnless}} && ({{confirmCondition $sub}}){{end}} {
+			if result := toolkit.Confirm(ctx, req, "gh {{argvString $.Command .}}", args.ConfirmToken, cmp.Or(dir, exec.Workdir()), defaults.Env(), cmd, {{with stdinArgs .Parameters}}{{range .}}args.{{toTitle .Name}}{{end}}{{else}}""{{end}}); result != nil {
+				return defaults.Annotate(result), nil, nil
+			}
+		}
+		{{- end}}
+
+		result, err := exec.Run(ctx, "{{toolName $.Command .}}", executor.Options{
+			Timeout: toolkit.Timeout(args.TimeoutSeconds, {{timeoutSeconds .}}),
+			Dir:         dir,
+			Env:         defaults.Env(),
+			Credentials: creds,
+			{{- range stdinArgs .Parameters}}
+			Stdin: args.{{toTitle .Name}},
+			{{- end}}
+			{{- if .SensitiveOutput}}
+			SensitiveOutput: true,
+			{{- end}}
+			{{- if .Streaming}}
+			OnLine: toolkit.Progress(ctx, req),
+			{{- end}}
+		}, cmd...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("gh {{$.Command}} {{.Name}} failed: %w", err)
+			return defaults.Annotate(toolkit.ErrorResult("gh {{argvString $.Command .}}", result, err)), nil, nil
 		}
+		{{- if .JSONOutput}}
+
+		if len(args.Json) > 0{{if .DefaultJSONFields}} && args.Format != "text"{{end}}{{if hasParam . "jq"}} && args.Jq == ""{{end}}{{if hasParam . "template"}} && args.Template == ""{{end}} {
+			return defaults.Annotate(toolkit.JSONResult(result.Stdout, {{.JSONOutput.Array}})), nil, nil
+		}
+
+		return defaults.Annotate(toolkit.TextResult(result.Stdout)), nil, nil
+		{{- else}}
 
-		return &mcp.CallToolResult{
+		return defaults.Annotate(&mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: result.Stdout},
 			},
-		}, nil, nil
+		}), nil, nil
+		{{- end}}
 	})
 }
 
 {{end}}
+// Register{{toTitle .Command}}Tools registers the gh {{.Command}} tools
+func Register{{toTitle .Command}}Tools(server *mcp.Server, exec executor.Runner) {
+	{{range .Subcommands -}}
+	Register{{toTitle $.Command}}{{toTitle .Name}}Tool(server, exec)
+	{{end -}}
+}
 `
 
 const registryTemplate = `// Code generated by tools/gen. DO NOT EDIT.
@@ -90,14 +244,40 @@ package generated
 import (
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/khalideidoo/mcp-go-gh/internal/executor"
+	"github.com/khalideidoo/mcp-go-gh/internal/toolset"
 )
 
-// RegisterAllTools registers all generated gh command tools
-func RegisterAllTools(server *mcp.Server, exec *executor.Executor) {
+// Toolsets lists the generated tools, grouped by gh command
+var Toolsets = []toolset.Toolset{
 	{{range $cmd := . -}}
-	{{range .Subcommands -}}
-	Register{{toTitle $cmd.Command}}{{toTitle .Name}}Tool(server, exec)
-	{{end}}
-	{{end}}
+	{Name: "{{$cmd.Command}}", Tools: []toolset.Tool{
+		{{range .Subcommands -}}
+		{Name: "{{toolName $cmd.Command .}}", {{with .Access}}Access: "{{.}}", {{end}}{{if readOnlyArgs .Parameters}}ReadOnlyConstrained: true, {{end}}{{if .SensitiveOutput}}SensitiveOutput: true, {{end}}Register: Register{{toTitle $cmd.Command}}{{toTitle .Name}}Tool},
+		{{end -}}
+	}},
+	{{end -}}
+}
+
+// RegisterAllTools registers all generated gh command tools
+func RegisterAllTools(server *mcp.Server, exec executor.Runner) {
+	{{range . -}}
+	Register{{toTitle .Command}}Tools(server, exec)
+	{{end -}}
+}
+`
+
+const testTemplate = `// Code generated by tools/gen. DO NOT EDIT.
+package generated
+
+import "testing"
+
+// Test{{toTitle .Command}}Argv calls every gh {{.Command}} tool with representative
+// arguments and compares the commands they run with testdata/{{.Command}}.golden
+func Test{{toTitle .Command}}Argv(t *testing.T) {
+	checkArgvGolden(t, "{{.Command}}", []argvCase{
+		{{range .Subcommands -}}
+		{Tool: "{{toolName $.Command .}}", Args: {{sampleArgs .}}},
+		{{end -}}
+	})
 }
 `
@@ -8,13 +8,99 @@ type CommandDefinition struct {
 }
 
 // Subcommand represents a specific gh subcommand.
+//
+// Name determines the generated tool name (gh_<command>_<name>). The argv
+// passed to gh defaults to <command> <name>; Root invokes <command> on its own
+// (e.g. gh status) and Argv spells out the full path when the gh name differs
+// from the tool name (e.g. gh auth setup-git).
+//
+// A subcommand that lists nested Subcommands is a group (e.g. gh repo
+// deploy-key): it produces no tool of its own and is flattened by
+// ParseDefinitions into leaves named <group>-<name>.
 type Subcommand struct {
-	Name        string      `yaml:"name"`
-	Description string      `yaml:"description"`
-	Parameters  []Parameter `yaml:"parameters"`
+	Name        string       `yaml:"name"`
+	Description string       `yaml:"description"`
+	Root        bool         `yaml:"root"`
+	Argv        []string     `yaml:"argv"`
+	Parameters  []Parameter  `yaml:"parameters"`
+	Subcommands []Subcommand `yaml:"subcommands"`
+	JSONOutput  *JSONOutput  `yaml:"json_output"`
+
+	// Access is what the subcommand can change: read (nothing, on GitHub
+	// or locally), write, or destructive (deletes data). It defaults to
+	// write, and only read tools are registered in read-only mode.
+	Access string `yaml:"access"`
+
+	// Title is the human-readable tool name shown by clients. It defaults
+	// to the description.
+	Title string `yaml:"title"`
+
+	// Idempotent marks write and destructive subcommands that have no
+	// further effect when repeated with the same arguments (e.g. closing
+	// an issue, setting a secret).
+	Idempotent bool `yaml:"idempotent"`
+
+	// Additive marks write subcommands that only add data (e.g. creating an
+	// issue, adding a key) and never overwrite or discard any. Only they
+	// advertise destructiveHint false; every other write tool is treated as
+	// possibly destructive.
+	Additive bool `yaml:"additive"`
+
+	// ConfirmUnless makes a write subcommand ask for confirmation like a
+	// destructive one, unless each named string argument takes one of the
+	// listed values (e.g. gh api with --method GET or HEAD). An omitted
+	// argument is not one of them.
+	ConfirmUnless map[string][]string `yaml:"confirm_unless"`
+
+	// OpenWorld reports whether the subcommand reaches GitHub. It defaults
+	// to true; local-only subcommands (e.g. gh alias, gh config) set it to
+	// false.
+	OpenWorld *bool `yaml:"open_world"`
+
+	// Timeout is a Go duration (e.g. 30s, 1h) overriding the server's
+	// default command timeout for this subcommand.
+	Timeout string `yaml:"timeout"`
+
+	// SensitiveOutput marks subcommands whose stdout is itself a secret
+	// (e.g. gh auth token); it is withheld when redaction mode is all.
+	SensitiveOutput bool `yaml:"sensitive_output"`
+
+	// Streaming reports each line of gh output to the client as a progress
+	// notification while long-running subcommands execute.
+	Streaming bool `yaml:"streaming"`
+
+	// DefaultJSONFields are requested with --json when the caller does not
+	// pick fields, so the tool returns structured data instead of gh's
+	// table output. Callers opt out with the synthetic format argument.
+	DefaultJSONFields []string `yaml:"default_json_fields"`
+}
+
+// JSONOutput describes what gh prints when a subcommand runs with --json.
+// It drives the tool's output schema, and makes the handler return the
+// decoded JSON as structured content. Array outputs are exposed as
+// {"items": [...]} since structured content must be an object.
+type JSONOutput struct {
+	Array  bool        `yaml:"array"`
+	Fields []JSONField `yaml:"fields"`
+}
+
+// JSONField represents one field selectable with --json.
+type JSONField struct {
+	Name        string `yaml:"name"`
+	Type        string `yaml:"type"`
+	Description string `yaml:"description"`
+	Nullable    bool   `yaml:"nullable"`
 }
 
 // Parameter represents a command parameter/flag.
+//
+// Nullable boolean and integer parameters are generated as pointers so that
+// an explicit false or 0 is forwarded to gh (--flag=false, --flag 0) instead
+// of being indistinguishable from an omitted argument.
+//
+// Stdin string parameters are piped to gh on standard input and never appear
+// in argv, keeping secrets out of the process list. When set, Flag is passed
+// on its own to tell gh to read stdin (e.g. --with-token, --input=-).
 type Parameter struct {
 	Name        string   `yaml:"name"`
 	Type        string   `yaml:"type"`
@@ -23,6 +109,23 @@ type Parameter struct {
 	Short       string   `yaml:"short"`
 	Description string   `yaml:"description"`
 	Enum        []string `yaml:"enum"`
+	Default     any      `yaml:"default"`
 	Required    bool     `yaml:"required"`
 	Positional  bool     `yaml:"positional"`
+	Nullable    bool     `yaml:"nullable"`
+	Stdin       bool     `yaml:"stdin"`
+
+	// ReadOnlyValue is the only value the parameter may take when the
+	// server runs in read-only mode (e.g. GET for gh api --method). A tool
+	// constrained this way is registered in read-only mode even though its
+	// access is not read.
+	ReadOnlyValue string `yaml:"read_only_value"`
+
+	// Separator joins the key and value of each map entry. It defaults to
+	// "="; gh api headers take ":" (e.g. -H Accept:application/json).
+	Separator string `yaml:"separator"`
+
+	// Synthetic parameters are added by the generator rather than declared
+	// in YAML. They shape the tool's behavior and are never passed to gh.
+	Synthetic bool `yaml:"-"`
 }